@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/influx6/faux/metrics"
+	"github.com/influx6/faux/metrics/custom"
+	"github.com/influx6/shogun/internals/flow"
+	"github.com/minio/cli"
+)
+
+func flowRunAction(c *cli.Context) error {
+	if c.NArg() == 0 || c.Args().First() == "" {
+		fmt.Println("⡿ Run `shogun flow run deploy.flow.yaml` to execute the steps of a flow file.")
+		fmt.Println("⡿ Run `shogun flow run -resume deploy.flow.yaml` to continue a failed flow.")
+		return nil
+	}
+
+	events := metrics.New()
+
+	if c.Bool("verbose") {
+		events = metrics.New(custom.StackDisplay(os.Stderr))
+	}
+
+	fl, err := flow.Load(c.Args().First())
+	if err != nil {
+		events.Emit(metrics.Errorf("Failed to load flow file: %+q", err))
+		return err
+	}
+
+	if err := buildQuietly(c); err != nil {
+		fmt.Println("⡿ Run `shogun build -dir=''` to build package directory first before running `shogun flow run`.")
+		return err
+	}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		events.Emit(metrics.Errorf("Failed to read current directory: %q", err))
+		return err
+	}
+
+	binaryPath := binPath()
+	if binDir := c.String("binDir"); binDir != "" {
+		binaryPath = binDir
+	}
+
+	stateFile := c.String("state")
+	if stateFile == "" {
+		stateFile = flow.StateFileFor(currentDir, fl)
+	}
+
+	runner := flow.Runner{
+		Metrics:   events,
+		StateFile: stateFile,
+		Resume:    c.Bool("resume"),
+		Invoke:    flow.ExecInvoker(binaryPath, events),
+	}
+
+	ctx, endTrace, err := startTrace(c, context.Background())
+	if err != nil {
+		return err
+	}
+
+	defer endTrace()
+
+	state, err := runner.Run(ctx, fl)
+
+	if jsonErr := json.NewEncoder(os.Stdout).Encode(state); jsonErr != nil {
+		return jsonErr
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⡿ Flow %q failed, run `shogun flow run -resume %s` to continue from the failed step.\n", fl.Name, filepath.Clean(c.Args().First()))
+		return err
+	}
+
+	return nil
+}
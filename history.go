@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/influx6/faux/exec"
+	"github.com/influx6/faux/metrics"
+	"github.com/influx6/faux/metrics/custom"
+	"github.com/influx6/shogun/internals/history"
+	"github.com/minio/cli"
+)
+
+// consts of the environment variables with which generated binaries are told to
+// record their invocations, and to keep their input within records.
+const (
+	recordEnv      = "SHOGUN_RECORD"
+	recordInputEnv = "SHOGUN_RECORD_INPUT"
+)
+
+// shareRecording sets generated binaries executed by shogun to record their invocations
+// if the `-record` flag is set, keeping their input if the `-record-input` flag is set.
+func shareRecording(c *cli.Context) {
+	if c.GlobalBool("record") {
+		os.Setenv(recordEnv, "true")
+	}
+
+	if c.GlobalBool("record-input") {
+		os.Setenv(recordInputEnv, "true")
+	}
+}
+
+func historyAction(c *cli.Context) error {
+	path, err := history.Path()
+	if err != nil {
+		return err
+	}
+
+	filter := history.Filter{
+		Binary:   c.String("bin"),
+		Function: c.String("fn"),
+		Failed:   c.Bool("failed"),
+		Limit:    c.Int("n"),
+	}
+
+	if since := c.String("since"); since != "" {
+		duration, err := time.ParseDuration(since)
+		if err != nil {
+			return fmt.Errorf("Invalid since duration: %+q", err)
+		}
+
+		filter.Since = time.Now().Add(-duration)
+	}
+
+	records, err := history.Read(path, filter)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		encoder := json.NewEncoder(os.Stdout)
+		for _, rec := range records {
+			if err := encoder.Encode(rec); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if len(records) == 0 {
+		fmt.Println("⠙ No recorded invocations found, run functions with `shogun -record` or SHOGUN_RECORD=true.")
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tTIME\tBINARY\tFUNCTION\tEXIT\tDURATION\tARGUMENTS")
+
+	for _, rec := range records {
+		fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			rec.ID,
+			rec.Time.Format(time.Stamp),
+			rec.Binary,
+			rec.Function,
+			rec.ExitCode,
+			rec.Duration.Round(time.Microsecond),
+			strings.Join(rec.Args, " "),
+		)
+	}
+
+	return writer.Flush()
+}
+
+func replayAction(c *cli.Context) error {
+	if c.NArg() == 0 {
+		fmt.Println("⡿ Run `shogun replay [ID]` to re-run a recorded invocation, see `shogun history` for IDs.")
+		return nil
+	}
+
+	events := metrics.New()
+
+	if c.Bool("verbose") {
+		events = metrics.New(custom.StackDisplay(os.Stderr))
+	}
+
+	path, err := history.Path()
+	if err != nil {
+		return err
+	}
+
+	rec, err := history.Find(path, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	if rec.Redacted {
+		return fmt.Errorf("record %s has redacted secrets in its arguments and must be re-run manually", rec.ID)
+	}
+
+	if rec.InputTruncated {
+		return fmt.Errorf("record %s only holds part of its input and can not be replayed", rec.ID)
+	}
+
+	if rec.InputOmitted {
+		return fmt.Errorf("record %s only holds the digest of its input, record with `-record-input` to replay invocations", rec.ID)
+	}
+
+	command := []string{filepath.Join(binPath(), rec.Binary)}
+	if rec.Timeout != 0 {
+		command = append(command, "-t="+rec.Timeout.String())
+	}
+
+	command = append(command, rec.Args...)
+
+	fmt.Fprintf(os.Stderr, "⡿ Replaying %s: %+q\n", rec.ID, strings.Join(command, " "))
+
+	ctx, cancel := signalContext(context.Background())
+	defer cancel()
+
+	// The output is captured with the limit of the record, so both hold as much of it.
+	limit := rec.Limit
+	if limit <= 0 {
+		limit = history.DefaultLimit
+	}
+
+	output := history.NewCapture(limit)
+	exitCode, _ := exec.New(
+		exec.Async(),
+		exec.Dir(rec.Dir),
+		exec.Commands(command...),
+		exec.Input(bytes.NewReader(rec.Input)),
+		exec.Output(output),
+		exec.Err(os.Stderr),
+	).ExecWithExitCode(ctx, events)
+
+	if exitCode != rec.ExitCode {
+		fmt.Printf("⡿ Exit code changed from %d to %d\n", rec.ExitCode, exitCode)
+	}
+
+	if output.Digest() == rec.OutputDigest {
+		fmt.Println("⠙ Output matches the recorded output.")
+
+		if exitCode != rec.ExitCode {
+			return cli.NewExitError("", 1)
+		}
+
+		return nil
+	}
+
+	recorded, replayed := rec.Output, output.Bytes()
+
+	switch {
+	case rec.OutputTruncated:
+		// Only the beginning of the recorded output is known, so only as much of the new output is compared.
+		if len(replayed) > len(recorded) {
+			replayed = replayed[:len(recorded)]
+		}
+
+		if bytes.Equal(recorded, replayed) {
+			fmt.Printf("⡿ Output differs from the recorded output after its first %d bytes, which were all that was recorded.\n", len(recorded))
+			return cli.NewExitError("", 1)
+		}
+
+		fmt.Printf("⡿ Output differs from the recorded output, comparing only its first %d bytes which were recorded:\n", len(recorded))
+	case output.Truncated():
+		fmt.Printf("⡿ Output differs from the recorded output, comparing only the first %d bytes of the new output:\n", len(replayed))
+	default:
+		fmt.Println("⡿ Output differs from the recorded output:")
+	}
+
+	diff, ok := history.Diff(recorded, replayed)
+	if !ok {
+		fmt.Printf("⡿ Output is too large to diff, its digest %s differs from the recorded %s\n", output.Digest(), rec.OutputDigest)
+		return cli.NewExitError("", 1)
+	}
+
+	fmt.Print(diff)
+
+	return cli.NewExitError("", 1)
+}
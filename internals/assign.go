@@ -0,0 +1,118 @@
+package internals
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// IsAssignment returns true/false if giving argument is a `key=value` or `key:=json`
+// assignment to a field of a function's JSON input.
+func IsAssignment(arg string) bool {
+	index := strings.Index(arg, "=")
+	if index <= 0 || strings.HasPrefix(arg, "-") {
+		return false
+	}
+
+	key := strings.TrimSuffix(arg[:index], ":")
+	return key != "" && !strings.ContainsAny(key, " \t/") && !strings.HasPrefix(key, ".") && !strings.HasSuffix(key, ".")
+}
+
+// Assignments returns the assignments of args and the rest of them apart.
+func Assignments(args []string) (assignments []string, rest []string) {
+	for _, arg := range args {
+		if IsAssignment(arg) {
+			assignments = append(assignments, arg)
+			continue
+		}
+
+		rest = append(rest, arg)
+	}
+
+	return
+}
+
+// Assign applies giving assignments to the JSON object of base, which may be empty,
+// returning the resulting JSON. Assignments like `name=bat` set strings, those like
+// `age:=3` set raw JSON and dotted keys like `address.city=Lagos` set fields of
+// nested objects, which are created when missing.
+func Assign(base []byte, assignments []string) ([]byte, error) {
+	payload := map[string]interface{}{}
+
+	if len(bytes.TrimSpace(base)) != 0 {
+		if err := decodeJSON(base, &payload); err != nil {
+			return nil, InvalidInput(fmt.Errorf("input must be a JSON object to be assigned to: %s", err))
+		}
+	}
+
+	for _, assignment := range assignments {
+		index := strings.Index(assignment, "=")
+		key, raw := assignment[:index], assignment[index+1:]
+
+		var value interface{} = raw
+		if strings.HasSuffix(key, ":") {
+			key = strings.TrimSuffix(key, ":")
+
+			if err := decodeJSON([]byte(raw), &value); err != nil {
+				return nil, InvalidInput(fmt.Errorf("%s:= expects JSON: %s", key, err))
+			}
+		}
+
+		if err := set(payload, strings.Split(key, "."), value); err != nil {
+			return nil, InvalidInput(fmt.Errorf("%s: %s", key, err))
+		}
+	}
+
+	return json.Marshal(payload)
+}
+
+// decodeJSON decodes the single JSON value of data into v, keeping numbers as
+// json.Number so large integers are written back without losing precision.
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return fmt.Errorf("invalid data after JSON value")
+	}
+
+	return nil
+}
+
+// set sets value at the path of keys within object.
+func set(object map[string]interface{}, keys []string, value interface{}) error {
+	for index, key := range keys[:len(keys)-1] {
+		if key == "" {
+			return fmt.Errorf("empty key within path")
+		}
+
+		next, ok := object[key]
+		if !ok || next == nil {
+			created := map[string]interface{}{}
+			object[key] = created
+			object = created
+			continue
+		}
+
+		nested, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%q is not an object", strings.Join(keys[:index+1], "."))
+		}
+
+		object = nested
+	}
+
+	last := keys[len(keys)-1]
+	if last == "" {
+		return fmt.Errorf("empty key within path")
+	}
+
+	object[last] = value
+	return nil
+}
@@ -0,0 +1,141 @@
+package internals
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsAssignment(t *testing.T) {
+	tests := []struct {
+		arg    string
+		assign bool
+	}{
+		{arg: "name=bat", assign: true},
+		{arg: "age:=3", assign: true},
+		{arg: "address.city=Lagos", assign: true},
+		{arg: "query=a=b", assign: true},
+		{arg: "name=", assign: true},
+		{arg: "--name=bat"},
+		{arg: "-n=bat"},
+		{arg: "=bat"},
+		{arg: ":=3"},
+		{arg: "first name=bat"},
+		{arg: "./assets/a=b"},
+		{arg: ".name=bat"},
+		{arg: "name.=bat"},
+		{arg: "bat"},
+	}
+
+	for _, test := range tests {
+		if assign := IsAssignment(test.arg); assign != test.assign {
+			t.Errorf("IsAssignment(%q) returned %t, expected %t", test.arg, assign, test.assign)
+		}
+	}
+}
+
+func TestAssignments(t *testing.T) {
+	assignments, rest := Assignments([]string{"greet", "name=bat", "--loud", "age:=3", "extra"})
+
+	if expected := []string{"name=bat", "age:=3"}; !reflect.DeepEqual(assignments, expected) {
+		t.Errorf("Assignments returned assignments %q, expected %q", assignments, expected)
+	}
+
+	if expected := []string{"greet", "--loud", "extra"}; !reflect.DeepEqual(rest, expected) {
+		t.Errorf("Assignments returned rest %q, expected %q", rest, expected)
+	}
+}
+
+func TestAssign(t *testing.T) {
+	tests := []struct {
+		name        string
+		base        string
+		assignments []string
+		result      string
+		err         bool
+	}{
+		{
+			name:        "strings and JSON",
+			assignments: []string{"name=bat", "age:=3", "admin:=true"},
+			result:      `{"admin":true,"age":3,"name":"bat"}`,
+		},
+		{
+			name:        "numbers are strings without :=",
+			assignments: []string{"age=3"},
+			result:      `{"age":"3"}`,
+		},
+		{
+			name:        "values containing equal signs",
+			assignments: []string{"query=a=b"},
+			result:      `{"query":"a=b"}`,
+		},
+		{
+			name:        "nested objects are created",
+			assignments: []string{"address.city=Lagos", "address.zip:=100"},
+			result:      `{"address":{"city":"Lagos","zip":100}}`,
+		},
+		{
+			name:        "fields of the base are kept or replaced",
+			base:        `{"name":"ade","tags":["a"],"address":{"city":"Abuja","street":"Main"}}`,
+			assignments: []string{"name=bat", "address.city=Lagos"},
+			result:      `{"address":{"city":"Lagos","street":"Main"},"name":"bat","tags":["a"]}`,
+		},
+		{
+			name:        "null fields become objects",
+			base:        `{"address":null}`,
+			assignments: []string{"address.city=Lagos"},
+			result:      `{"address":{"city":"Lagos"}}`,
+		},
+		{
+			name:        "whitespace base",
+			base:        " \n",
+			assignments: []string{"name=bat"},
+			result:      `{"name":"bat"}`,
+		},
+		{
+			name:        "large integers keep their precision",
+			base:        `{"id":9007199254740993,"ratio":0.1}`,
+			assignments: []string{"parent:=9007199254740995"},
+			result:      `{"id":9007199254740993,"parent":9007199254740995,"ratio":0.1}`,
+		},
+		{
+			name:        "data after a JSON value",
+			assignments: []string{"age:=3 4"},
+			err:         true,
+		},
+		{
+			name:        "base which is not an object",
+			base:        `[1, 2]`,
+			assignments: []string{"name=bat"},
+			err:         true,
+		},
+		{
+			name:        "invalid JSON value",
+			assignments: []string{"age:=three"},
+			err:         true,
+		},
+		{
+			name:        "field which is not an object",
+			base:        `{"name":"bat"}`,
+			assignments: []string{"name.first=bat"},
+			err:         true,
+		},
+		{
+			name:        "empty key within path",
+			assignments: []string{"address..city=Lagos"},
+			err:         true,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := Assign([]byte(test.base), test.assignments)
+
+		switch {
+		case test.err && err == nil:
+			t.Errorf("%s: Assign returned %s, expected an error", test.name, result)
+		case !test.err && err != nil:
+			t.Errorf("%s: Assign failed: %s", test.name, err)
+		case !test.err && string(result) != test.result:
+			t.Errorf("%s: Assign returned %s, expected %s", test.name, result, test.result)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+// Package batch calls a function for every line of NDJSON input within a single
+// process, writing a line with the output or error of each call.
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// maxLine limits the size of a line of input.
+const maxLine = 64 << 20
+
+// Runner defines a function which calls the batch's function with the input of a
+// line, writing its output into output.
+type Runner func(ctx context.Context, input io.Reader, output io.Writer) error
+
+// Record is written for every line of input, with the output of the call as JSON
+// when it's valid JSON or as a string otherwise, or with its error envelope.
+type Record struct {
+	Line   int                      `json:"line"`
+	Output json.RawMessage          `json:"output,omitempty"`
+	Error  *internals.ErrorEnvelope `json:"error,omitempty"`
+}
+
+// Summary contains the results of a batch.
+type Summary struct {
+	Records int
+	Failed  int
+
+	// First is the error envelope of the first failed line.
+	First *internals.ErrorEnvelope
+}
+
+// Batch calls a function for each line of input, with Workers calls running at once.
+type Batch struct {
+	// Function is the name of the function, used within error envelopes.
+	Function string
+
+	// Call is called for every line which is not empty.
+	Call Runner
+
+	// Workers sets the number of calls running at once, defaulting to 1.
+	Workers int
+
+	// Unordered writes records as calls end, rather than in the order of input.
+	Unordered bool
+
+	// FailFast stops reading input after the first failed call, though the
+	// records of calls already running are still written.
+	FailFast bool
+}
+
+type job struct {
+	seq    int
+	line   int
+	data   []byte
+	record Record
+
+	// skipped is set for jobs taken after reading stopped, which are not written.
+	skipped bool
+}
+
+// Run calls the function for each line read from r until it ends or ctx is
+// cancelled, writing the records of calls into w as JSON lines.
+func (b *Batch) Run(ctx context.Context, r io.Reader, w io.Writer) (Summary, error) {
+	workers := b.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	// Cancelling stop ends the reading of input, leaving running calls to end.
+	stop, halt := context.WithCancel(ctx)
+	defer halt()
+
+	// The window bounds records waiting to be written, so ordered output does not
+	// grow without end behind a slow call.
+	window := make(chan struct{}, workers*4)
+	jobs := make(chan job)
+	records := make(chan job)
+
+	var summary Summary
+	var readErr error
+
+	// Lines are read apart from dispatching them, so stopping does not wait for input
+	// which may never end.
+	lines := make(chan job)
+	failed := make(chan error, 1)
+
+	go func() {
+		defer close(lines)
+
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), maxLine)
+
+		var line int
+		for scanner.Scan() {
+			line++
+
+			data := bytes.TrimSpace(scanner.Bytes())
+			if len(data) == 0 {
+				continue
+			}
+
+			select {
+			case lines <- job{line: line, data: append([]byte(nil), data...)}:
+			case <-stop.Done():
+				return
+			}
+		}
+
+		failed <- scanner.Err()
+	}()
+
+	go func() {
+		defer close(jobs)
+
+		for seq := 0; ; seq++ {
+			var jb job
+			var ok bool
+
+			select {
+			case jb, ok = <-lines:
+			case <-stop.Done():
+				return
+			}
+
+			// Input which ended sends its error before closing lines, unlike stopped reading.
+			if !ok {
+				select {
+				case readErr = <-failed:
+				default:
+				}
+
+				return
+			}
+
+			select {
+			case window <- struct{}{}:
+			case <-stop.Done():
+				return
+			}
+
+			jb.seq = seq
+
+			select {
+			case jobs <- jb:
+			case <-stop.Done():
+				return
+			}
+		}
+	}()
+
+	var waiter sync.WaitGroup
+	waiter.Add(workers)
+
+	for index := 0; index < workers; index++ {
+		go func() {
+			defer waiter.Done()
+
+			for jb := range jobs {
+				if stop.Err() != nil {
+					jb.skipped = true
+					records <- jb
+					continue
+				}
+
+				var output bytes.Buffer
+				jb.record.Line = jb.line
+
+				if err := b.Call(internals.WithInvocationID(ctx, internals.NewInvocationID()), bytes.NewReader(jb.data), &output); err != nil {
+					envelope := internals.NewErrorEnvelope(b.Function, err)
+					jb.record.Error = &envelope
+
+					if b.FailFast {
+						halt()
+					}
+				} else {
+					jb.record.Output = outputOf(output.Bytes())
+				}
+
+				records <- jb
+			}
+		}()
+	}
+
+	go func() {
+		waiter.Wait()
+		close(records)
+	}()
+
+	var writeErr error
+	write := func(jb job) {
+		<-window
+
+		if jb.skipped {
+			return
+		}
+
+		summary.Records++
+		if jb.record.Error != nil {
+			summary.Failed++
+
+			if summary.First == nil {
+				summary.First = jb.record.Error
+			}
+		}
+
+		if writeErr != nil {
+			return
+		}
+
+		data, err := json.Marshal(jb.record)
+		if err == nil {
+			_, err = w.Write(append(data, '\n'))
+		}
+
+		if writeErr = err; writeErr != nil {
+			halt()
+		}
+	}
+
+	// Records ending out of order wait for those before them.
+	waiting := map[int]job{}
+	var next int
+
+	for jb := range records {
+		if b.Unordered {
+			write(jb)
+			continue
+		}
+
+		waiting[jb.seq] = jb
+		for {
+			ready, ok := waiting[next]
+			if !ok {
+				break
+			}
+
+			delete(waiting, next)
+			write(ready)
+			next++
+		}
+	}
+
+	if writeErr != nil {
+		return summary, writeErr
+	}
+
+	if readErr != nil {
+		return summary, readErr
+	}
+
+	return summary, nil
+}
+
+// outputOf returns output as JSON, quoting it as a string if it's not valid JSON.
+func outputOf(output []byte) json.RawMessage {
+	trimmed := bytes.TrimSpace(output)
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	if json.Valid(trimmed) {
+		return json.RawMessage(trimmed)
+	}
+
+	quoted, _ := json.Marshal(string(output))
+	return json.RawMessage(quoted)
+}
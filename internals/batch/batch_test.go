@@ -0,0 +1,153 @@
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// square writes the square of the number on its line after sleeping that many
+// milliseconds, so greater numbers end later, and fails for negative ones.
+func square(running *int32, most *int32) Runner {
+	return func(ctx context.Context, input io.Reader, output io.Writer) error {
+		now := atomic.AddInt32(running, 1)
+		defer atomic.AddInt32(running, -1)
+
+		for {
+			seen := atomic.LoadInt32(most)
+			if now <= seen || atomic.CompareAndSwapInt32(most, seen, now) {
+				break
+			}
+		}
+
+		data, _ := io.ReadAll(input)
+		n, err := strconv.Atoi(string(data))
+		if err != nil {
+			fmt.Fprintf(output, "not a number: %s", data)
+			return nil
+		}
+
+		if n < 0 {
+			return fmt.Errorf("negative %d", n)
+		}
+
+		time.Sleep(time.Duration(n) * time.Millisecond)
+		fmt.Fprintf(output, "%d", n*n)
+		return nil
+	}
+}
+
+func records(t *testing.T, out string) []string {
+	t.Helper()
+
+	var got []string
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		var record Record
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid record %q: %s", line, err)
+		}
+
+		if record.Error != nil {
+			got = append(got, fmt.Sprintf("%d! %s", record.Line, record.Error.Message))
+			continue
+		}
+
+		got = append(got, fmt.Sprintf("%d: %s", record.Line, record.Output))
+	}
+
+	return got
+}
+
+func TestOrderedRecords(t *testing.T) {
+	var running, most int32
+	b := &Batch{Function: "Square", Call: square(&running, &most), Workers: 3}
+
+	var out strings.Builder
+	summary, err := b.Run(context.Background(), strings.NewReader("40\n\n30\n-1\n  \nfour\n2\n"), &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{`1: 1600`, `3: 900`, `4! negative -1`, `6: "not a number: four"`, `7: 4`}
+	if got := records(t, out.String()); strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("records:\n%s\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+
+	if summary.Records != 5 || summary.Failed != 1 || summary.First == nil || summary.First.Function != "Square" {
+		t.Errorf("summary %+v", summary)
+	}
+
+	if most != 3 {
+		t.Errorf("%d calls ran at once, expected 3 workers", most)
+	}
+}
+
+func TestUnorderedRecords(t *testing.T) {
+	var running, most int32
+	b := &Batch{Call: square(&running, &most), Workers: 2, Unordered: true}
+
+	var out strings.Builder
+	if _, err := b.Run(context.Background(), strings.NewReader("80\n1\n"), &out); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := records(t, out.String()); strings.Join(got, ",") != "2: 1,1: 6400" {
+		t.Errorf("records %q, expected them as calls end", got)
+	}
+}
+
+func TestFailFast(t *testing.T) {
+	var running, most int32
+	b := &Batch{Call: square(&running, &most), FailFast: true}
+
+	// Input which never ends does not keep a failed batch running.
+	in, client := io.Pipe()
+	defer client.Close()
+
+	go io.WriteString(client, "1\n-2\n3\n")
+
+	var out strings.Builder
+	done := make(chan Summary)
+	go func() {
+		summary, _ := b.Run(context.Background(), in, &out)
+		done <- summary
+	}()
+
+	select {
+	case summary := <-done:
+		if summary.Records != 2 || summary.Failed != 1 {
+			t.Errorf("summary %+v", summary)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("batch did not stop after the failed call")
+	}
+
+	if got := records(t, out.String()); strings.Join(got, ",") != "1: 1,2! negative -2" {
+		t.Errorf("records %q", got)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("pipe closed") }
+
+func TestWriteAndReadErrors(t *testing.T) {
+	var running, most int32
+	b := &Batch{Call: square(&running, &most)}
+
+	if _, err := b.Run(context.Background(), strings.NewReader("1\n2\n"), failingWriter{}); err == nil || err.Error() != "pipe closed" {
+		t.Errorf("Run returned %v, expected the write error", err)
+	}
+
+	long := strings.Repeat("1", maxLine+1)
+	if _, err := b.Run(context.Background(), strings.NewReader(long), io.Discard); err == nil {
+		t.Error("Run accepted a line above the limit")
+	}
+}
@@ -0,0 +1,65 @@
+package internals
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+type contextKey string
+
+// consts of context keys.
+const (
+	changedPathsKey contextKey = "shogun:changed-paths"
+	attemptKey      contextKey = "shogun:attempt"
+	crashOnPanicKey contextKey = "shogun:crash-on-panic"
+	invocationKey   contextKey = "shogun:invocation"
+	scopeKey        contextKey = "shogun:scope"
+	resolvingKey    contextKey = "shogun:resolving"
+)
+
+// WithChangedPaths returns a new context which carries the giving changed paths.
+func WithChangedPaths(ctx context.Context, paths []string) context.Context {
+	return context.WithValue(ctx, changedPathsKey, paths)
+}
+
+// ChangedPaths returns the paths whose changes triggered a function declared
+// with a @watch annotation.
+func ChangedPaths(ctx context.Context) ([]string, bool) {
+	paths, ok := ctx.Value(changedPathsKey).([]string)
+	return paths, ok
+}
+
+// WithAttempt returns a new context which carries the number of the attempt
+// at calling a function.
+func WithAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey, attempt)
+}
+
+// Attempt returns the number of the attempt at calling a function declared with
+// a @retry annotation, starting from 1.
+func Attempt(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptKey).(int); ok {
+		return attempt
+	}
+
+	return 1
+}
+
+// WithInvocationID returns a new context which carries the ID of an invocation.
+func WithInvocationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, invocationKey, id)
+}
+
+// InvocationID returns the ID of the invocation within ctx.
+func InvocationID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(invocationKey).(string)
+	return id, ok
+}
+
+// NewInvocationID returns a new random ID for an invocation.
+func NewInvocationID() string {
+	id := make([]byte, 6)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
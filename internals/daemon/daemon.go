@@ -0,0 +1,378 @@
+// Package daemon keeps a generated binary running behind a Unix socket, calling
+// its functions for requests of clients like `shogun` without starting a process
+// for each of them.
+//
+// Requests and responses are sent as frames of a type byte, a big-endian uint32
+// length and a payload. A client sends a request frame and waits for it to be
+// accepted, then streams its input as input frames ended by an empty one, while
+// the daemon streams output and stderr frames ended by an exit frame.
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// consts of frame types.
+const (
+	FrameRequest = 'Q'
+	FrameAccept  = 'A'
+	FrameReject  = 'J'
+	FrameInput   = 'I'
+	FrameOutput  = 'O'
+	FrameStderr  = 'E'
+	FrameExit    = 'X'
+)
+
+// consts of frame sizes, where written data is split into frames of up to chunkSize
+// bytes and maxFrame limits the size of frames read, as a guard against corrupt streams.
+const (
+	chunkSize = 1 << 20
+	maxFrame  = 16 << 20
+)
+
+// errors.
+var (
+	// ErrRejected is returned by Run when a daemon does not handle a request, which
+	// should then be run by executing the binary.
+	ErrRejected = errors.New("request rejected by daemon")
+)
+
+// Request asks a daemon to run its binary with giving arguments, as if they
+// were given on the command line within Dir and with the environment of Env.
+// As functions read the environment of the daemon's process, requests whose
+// Env differs from it are rejected.
+type Request struct {
+	Args []string `json:"args"`
+	Dir  string   `json:"dir"`
+	Env  []string `json:"env"`
+}
+
+// Exit ends the response of a request with its exit code.
+type Exit struct {
+	Code int `json:"code"`
+}
+
+// Call runs an accepted request, returning its exit code.
+type Call func(ctx context.Context, input io.Reader, stdout io.Writer, stderr io.Writer) int
+
+// Preparer returns the Call for a request, or an error if the daemon does not handle it.
+type Preparer func(req Request) (Call, error)
+
+// SocketPath returns the socket of giving binary's daemon, which is within the
+// directory set by the SHOGUN_SOCKET_DIR environment variable or a directory of
+// the user's within the temporary directory. Listen and Run only use sockets
+// within directories owned by the current user with mode 0700.
+func SocketPath(binary string) string {
+	dir := os.Getenv("SHOGUN_SOCKET_DIR")
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), fmt.Sprintf("shogun-%d", os.Getuid()))
+	}
+
+	return filepath.Join(dir, binary+".sock")
+}
+
+// Listen listens on the socket at path, replacing a stale socket left behind by
+// a daemon which is gone, but failing if a daemon is still listening on it.
+func Listen(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	if err := private(filepath.Dir(path)); err != nil {
+		return nil, err
+	}
+
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return nil, fmt.Errorf("A daemon is already listening on %q", path)
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return net.Listen("unix", path)
+}
+
+// Serve accepts connections from listener until ctx is cancelled, serving each
+// request on its own, and waits for running requests before returning.
+func Serve(ctx context.Context, listener net.Listener, prepare Preparer) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer conn.Close()
+
+			serveConn(ctx, conn, prepare)
+		}()
+	}
+}
+
+// serveConn serves the request of a connection, whose context is cancelled if the
+// client goes away before its response ends.
+func serveConn(ctx context.Context, conn net.Conn, prepare Preparer) {
+	reader := bufio.NewReader(conn)
+
+	kind, payload, err := ReadFrame(reader)
+	if err != nil || kind != FrameRequest {
+		return
+	}
+
+	var req Request
+	if err := json.Unmarshal(payload, &req); err != nil {
+		WriteFrame(conn, FrameReject, []byte(err.Error()))
+		return
+	}
+
+	if !sameEnv(req.Env, os.Environ()) {
+		WriteFrame(conn, FrameReject, []byte("daemon runs with another environment"))
+		return
+	}
+
+	call, err := prepare(req)
+	if err != nil {
+		WriteFrame(conn, FrameReject, []byte(err.Error()))
+		return
+	}
+
+	if err := WriteFrame(conn, FrameAccept, nil); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Closing input when the call returns ends the reading of input it did not read.
+	input, inputWriter := io.Pipe()
+	defer input.Close()
+
+	go func() {
+		for {
+			kind, payload, err := ReadFrame(reader)
+			if err != nil {
+				inputWriter.CloseWithError(err)
+				cancel()
+				return
+			}
+
+			if kind != FrameInput {
+				continue
+			}
+
+			// An empty input frame ends the input, after which only the end of the connection matters.
+			if len(payload) == 0 {
+				inputWriter.Close()
+
+				io.Copy(io.Discard, reader)
+				cancel()
+				return
+			}
+
+			if _, err := inputWriter.Write(payload); err != nil {
+				return
+			}
+		}
+	}()
+
+	out := &frameWriter{w: conn}
+	code := call(ctx, input, out.of(FrameOutput), out.of(FrameStderr))
+
+	data, _ := json.Marshal(Exit{Code: code})
+	out.write(FrameExit, data)
+}
+
+// Run sends giving request to the daemon listening on path, streaming input to it
+// and its response into stdout and stderr, and returns the exit code of the
+// request. It returns ErrRejected if the daemon does not handle the request, in
+// which case input was not read.
+func Run(ctx context.Context, path string, req Request, input io.Reader, stdout io.Writer, stderr io.Writer) (int, error) {
+	if err := private(filepath.Dir(path)); err != nil {
+		return -1, err
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", path)
+	if err != nil {
+		return -1, err
+	}
+
+	defer conn.Close()
+
+	// Cancelling ctx ends the connection, which cancels the request within the daemon.
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return -1, err
+	}
+
+	if err := WriteFrame(conn, FrameRequest, data); err != nil {
+		return -1, err
+	}
+
+	reader := bufio.NewReader(conn)
+
+	kind, payload, err := ReadFrame(reader)
+	if err != nil {
+		return -1, err
+	}
+
+	if kind == FrameReject {
+		return -1, fmt.Errorf("%w: %s", ErrRejected, payload)
+	}
+
+	if kind != FrameAccept {
+		return -1, fmt.Errorf("Unexpected frame %q from daemon", kind)
+	}
+
+	out := &frameWriter{w: conn}
+	go func() {
+		io.Copy(out.of(FrameInput), input)
+		out.write(FrameInput, nil)
+	}()
+
+	for {
+		kind, payload, err := ReadFrame(reader)
+		if err != nil {
+			if ctx.Err() != nil {
+				return -1, ctx.Err()
+			}
+
+			return -1, err
+		}
+
+		switch kind {
+		case FrameOutput:
+			stdout.Write(payload)
+		case FrameStderr:
+			stderr.Write(payload)
+		case FrameExit:
+			var exit Exit
+			if err := json.Unmarshal(payload, &exit); err != nil {
+				return -1, err
+			}
+
+			return exit.Code, nil
+		}
+	}
+}
+
+// sameEnv returns true/false if both environments hold the same variables, in any
+// order, leaving out those which shells keep for themselves: `_`, set to the path
+// of the executed program, and SHLVL, counting nested shells.
+func sameEnv(env []string, other []string) bool {
+	vars := func(env []string) map[string]string {
+		set := make(map[string]string, len(env))
+		for _, item := range env {
+			if name, value, _ := strings.Cut(item, "="); name != "_" && name != "SHLVL" {
+				set[name] = value
+			}
+		}
+		return set
+	}
+
+	return maps.Equal(vars(env), vars(other))
+}
+
+// WriteFrame writes a frame of giving kind and payload into w.
+func WriteFrame(w io.Writer, kind byte, payload []byte) error {
+	frame := make([]byte, 5+len(payload))
+	frame[0] = kind
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(payload)))
+	copy(frame[5:], payload)
+
+	_, err := w.Write(frame)
+	return err
+}
+
+// ReadFrame reads a frame from r, returning its kind and payload.
+func ReadFrame(r io.Reader) (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[1:5])
+	if size > maxFrame {
+		return 0, nil, fmt.Errorf("Frame of %d bytes exceeds limit of %d bytes", size, maxFrame)
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+
+	return header[0], payload, nil
+}
+
+// frameWriter writes frames into w, one at a time.
+type frameWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (f *frameWriter) write(kind byte, payload []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return WriteFrame(f.w, kind, payload)
+}
+
+// of returns a writer which writes data as frames of giving kind.
+func (f *frameWriter) of(kind byte) io.Writer {
+	return kindWriter{frames: f, kind: kind}
+}
+
+type kindWriter struct {
+	frames *frameWriter
+	kind   byte
+}
+
+// Write implements the io.Writer interface.
+func (k kindWriter) Write(data []byte) (int, error) {
+	var written int
+	for written < len(data) {
+		chunk := data[written:]
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+
+		if err := k.frames.write(k.kind, chunk); err != nil {
+			return written, err
+		}
+
+		written += len(chunk)
+	}
+
+	return written, nil
+}
@@ -0,0 +1,146 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// startDaemon serves an echoing daemon on a socket within a private directory,
+// returning the socket's path.
+func startDaemon(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "sockets", "demo.sock")
+
+	listener, err := Listen(path)
+	if err != nil {
+		t.Fatalf("Listen failed: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() {
+		done <- Serve(ctx, listener, func(req Request) (Call, error) {
+			if len(req.Args) == 0 || req.Args[0] != "shout" {
+				return nil, errors.New("daemon only calls shout")
+			}
+
+			return func(ctx context.Context, input io.Reader, stdout io.Writer, stderr io.Writer) int {
+				data, _ := io.ReadAll(input)
+				fmt.Fprint(stdout, strings.ToUpper(string(data)))
+				fmt.Fprintf(stderr, "shouted within %s", req.Dir)
+				return 3
+			}, nil
+		})
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Serve failed: %s", err)
+		}
+	})
+
+	return path
+}
+
+func TestRun(t *testing.T) {
+	path := startDaemon(t)
+
+	var stdout, stderr bytes.Buffer
+	req := Request{Args: []string{"shout"}, Dir: "/work", Env: os.Environ()}
+
+	code, err := Run(context.Background(), path, req, strings.NewReader("hello"), &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("Run failed: %s", err)
+	}
+
+	if code != 3 || stdout.String() != "HELLO" || stderr.String() != "shouted within /work" {
+		t.Errorf("Run returned %d with %q and %q", code, stdout.String(), stderr.String())
+	}
+
+	if _, err := Listen(path); err == nil || !strings.Contains(err.Error(), "already listening") {
+		t.Errorf("second Listen on a served socket returned %v", err)
+	}
+}
+
+func TestRunRejected(t *testing.T) {
+	path := startDaemon(t)
+
+	requests := map[string]Request{
+		"unknown function":    {Args: []string{"whisper"}, Env: os.Environ()},
+		"another environment": {Args: []string{"shout"}, Env: append(os.Environ(), "SHOGUN_DAEMON_TEST=client")},
+	}
+
+	for name, req := range requests {
+		var stdout bytes.Buffer
+
+		_, err := Run(context.Background(), path, req, strings.NewReader("hello"), &stdout, io.Discard)
+		if !errors.Is(err, ErrRejected) {
+			t.Errorf("%s: Run returned %v, expected ErrRejected", name, err)
+		}
+
+		if stdout.Len() != 0 {
+			t.Errorf("%s: rejected request wrote %q", name, stdout.String())
+		}
+	}
+}
+
+func TestSocketDirectoryMustBePrivate(t *testing.T) {
+	path := startDaemon(t)
+	dir := filepath.Dir(path)
+
+	if err := os.Chmod(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	req := Request{Args: []string{"shout"}, Env: os.Environ()}
+	if _, err := Run(context.Background(), path, req, strings.NewReader(""), io.Discard, io.Discard); err == nil || !strings.Contains(err.Error(), "expected 0700") {
+		t.Errorf("Run within a shared directory returned %v", err)
+	}
+
+	if _, err := Listen(filepath.Join(dir, "other.sock")); err == nil {
+		t.Errorf("Listen within a shared directory succeeded")
+	}
+
+	if err := os.Chmod(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	link := filepath.Join(t.TempDir(), "link")
+	if err := os.Symlink(dir, link); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Listen(filepath.Join(link, "other.sock")); err == nil {
+		t.Errorf("Listen within a linked directory succeeded")
+	}
+}
+
+func TestSameEnv(t *testing.T) {
+	tests := []struct {
+		env, other []string
+		same       bool
+	}{
+		{env: []string{"A=1", "B=2"}, other: []string{"B=2", "A=1"}, same: true},
+		{env: []string{"A=1", "_=/usr/bin/shogun"}, other: []string{"_=/usr/bin/demo", "A=1"}, same: true},
+		{env: []string{"A=1", "SHLVL=1"}, other: []string{"A=1", "SHLVL=2"}, same: true},
+		{env: []string{"A=1"}, other: []string{"A=2"}},
+		{env: []string{"A=1"}, other: []string{"A=1", "B="}},
+		{env: nil, other: []string{"A=1"}},
+	}
+
+	for _, test := range tests {
+		if same := sameEnv(test.env, test.other); same != test.same {
+			t.Errorf("sameEnv(%q, %q) returned %t", test.env, test.other, same)
+		}
+	}
+}
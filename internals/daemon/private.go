@@ -0,0 +1,33 @@
+//go:build !windows
+// +build !windows
+
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// private returns an error unless dir is a directory, not a link, owned by the
+// current user and closed to everyone else, so sockets within it can be trusted.
+func private(dir string) error {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("Socket directory %q is not a directory", dir)
+	}
+
+	if stat, ok := info.Sys().(*syscall.Stat_t); !ok || int(stat.Uid) != os.Getuid() {
+		return fmt.Errorf("Socket directory %q is not owned by the current user", dir)
+	}
+
+	if info.Mode().Perm() != 0700 {
+		return fmt.Errorf("Socket directory %q has mode %#o, expected 0700", dir, info.Mode().Perm())
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+)
+
+// private returns an error unless dir is a directory and not a link, as the
+// ownership of directories is not reported by Windows.
+func private(dir string) error {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("Socket directory %q is not a directory", dir)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package internals
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// consts of error codes and exit codes used when errors do not provide theirs.
+const (
+	CodeError        = "error"
+	CodeInvalidInput = "invalid_input"
+	CodeTimeout      = "timeout"
+	CodeCancelled    = "cancelled"
+
+	ExitError        = 1
+	ExitInvalidInput = 2
+	ExitTimeout      = 124
+	ExitCancelled    = 130
+)
+
+// ExitCoder defines an error which sets the exit code of a binary.
+type ExitCoder interface {
+	ExitCode() int
+}
+
+// Coder defines an error which sets the code of its ErrorEnvelope.
+type Coder interface {
+	Code() string
+}
+
+// Detailer defines an error which provides details for its ErrorEnvelope.
+type Detailer interface {
+	Details() interface{}
+}
+
+// Retrier defines an error which reports if the failed call can be retried.
+type Retrier interface {
+	Retryable() bool
+}
+
+// ErrorEnvelope is the documented structure of errors returned by functions,
+// written to stderr by generated binaries.
+type ErrorEnvelope struct {
+	Code      string      `json:"code"`
+	Message   string      `json:"message"`
+	Details   interface{} `json:"details,omitempty"`
+	Function  string      `json:"function"`
+	Retryable bool        `json:"retryable"`
+	ExitCode  int         `json:"exit_code"`
+}
+
+// NewErrorEnvelope returns the ErrorEnvelope for giving error of function. Errors
+// within the chain of err which implement ExitCoder, Coder, Detailer or Retrier set
+// the respective fields, else defaults are used based on the kind of error.
+func NewErrorEnvelope(function string, err error) ErrorEnvelope {
+	envelope := ErrorEnvelope{
+		Function: function,
+		Message:  err.Error(),
+		Code:     CodeError,
+		ExitCode: ExitError,
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		envelope.Code = CodeTimeout
+		envelope.ExitCode = ExitTimeout
+		envelope.Retryable = true
+	case errors.Is(err, context.Canceled):
+		envelope.Code = CodeCancelled
+		envelope.ExitCode = ExitCancelled
+		envelope.Retryable = true
+	}
+
+	var coder Coder
+	if errors.As(err, &coder) {
+		envelope.Code = coder.Code()
+	}
+
+	var exitCoder ExitCoder
+	if errors.As(err, &exitCoder) {
+		envelope.ExitCode = exitCoder.ExitCode()
+	}
+
+	var detailer Detailer
+	if errors.As(err, &detailer) {
+		envelope.Details = detailer.Details()
+	}
+
+	var retrier Retrier
+	if errors.As(err, &retrier) {
+		envelope.Retryable = retrier.Retryable()
+	}
+
+	return envelope
+}
+
+// WriteError writes giving envelope into w, as a JSON line if format is "json"
+// or as a line of text if "text".
+func WriteError(w io.Writer, format string, envelope ErrorEnvelope) error {
+	switch format {
+	case "", "json":
+		return json.NewEncoder(w).Encode(envelope)
+	case "text":
+		var details string
+		if envelope.Details != nil {
+			if data, err := json.Marshal(envelope.Details); err == nil {
+				details = " " + string(data)
+			}
+		}
+
+		_, err := fmt.Fprintf(w, "%s: %s (code: %s, exitCode: %d)%s\n", envelope.Function, envelope.Message, envelope.Code, envelope.ExitCode, details)
+		return err
+	default:
+		return fmt.Errorf("unknown error format %q, expected text or json", format)
+	}
+}
+
+// ResultEnvelope contains the outcome of a function call, written to stdout by
+// generated binaries when asked for an envelope.
+type ResultEnvelope struct {
+	Function string          `json:"function"`
+	Result   json.RawMessage `json:"result"`
+	Error    *ErrorEnvelope  `json:"error"`
+	Duration string          `json:"duration"`
+}
+
+// NewResultEnvelope returns a ResultEnvelope for giving output of function, which
+// is kept as is if it is JSON, else as a JSON string.
+func NewResultEnvelope(function string, output []byte, envelope *ErrorEnvelope, duration time.Duration) ResultEnvelope {
+	result := ResultEnvelope{
+		Function: function,
+		Error:    envelope,
+		Duration: duration.String(),
+		Result:   json.RawMessage("null"),
+	}
+
+	if trimmed := bytes.TrimSpace(output); len(trimmed) != 0 {
+		if json.Valid(trimmed) {
+			result.Result = json.RawMessage(trimmed)
+		} else if data, err := json.Marshal(string(output)); err == nil {
+			result.Result = json.RawMessage(data)
+		}
+	}
+
+	return result
+}
+
+// InvalidInputError is returned when the input of a function can not be decoded.
+type InvalidInputError struct {
+	Err error
+}
+
+// InvalidInput returns a new InvalidInputError for giving decoding error.
+func InvalidInput(err error) error {
+	return InvalidInputError{Err: err}
+}
+
+// Error implements the error interface.
+func (e InvalidInputError) Error() string {
+	return fmt.Sprintf("Expected Valid JSON: %+q", e.Err)
+}
+
+// Unwrap returns the decoding error.
+func (e InvalidInputError) Unwrap() error {
+	return e.Err
+}
+
+// Code implements the Coder interface.
+func (e InvalidInputError) Code() string {
+	return CodeInvalidInput
+}
+
+// ExitCode implements the ExitCoder interface.
+func (e InvalidInputError) ExitCode() int {
+	return ExitInvalidInput
+}
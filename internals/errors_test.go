@@ -0,0 +1,116 @@
+package internals
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type quotaError struct {
+	limit int
+}
+
+func (e quotaError) Error() string        { return fmt.Sprintf("quota of %d reached", e.limit) }
+func (e quotaError) Code() string         { return "quota" }
+func (e quotaError) ExitCode() int        { return 75 }
+func (e quotaError) Retryable() bool      { return false }
+func (e quotaError) Details() interface{} { return map[string]int{"limit": e.limit} }
+
+func TestNewErrorEnvelope(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected ErrorEnvelope
+	}{
+		{
+			err:      errors.New("broken"),
+			expected: ErrorEnvelope{Code: CodeError, Message: "broken", ExitCode: ExitError},
+		},
+		{
+			err:      fmt.Errorf("fetching: %w", context.DeadlineExceeded),
+			expected: ErrorEnvelope{Code: CodeTimeout, Message: "fetching: context deadline exceeded", ExitCode: ExitTimeout, Retryable: true},
+		},
+		{
+			err:      context.Canceled,
+			expected: ErrorEnvelope{Code: CodeCancelled, Message: "context canceled", ExitCode: ExitCancelled, Retryable: true},
+		},
+		{
+			err:      InvalidInput(errors.New("bad")),
+			expected: ErrorEnvelope{Code: CodeInvalidInput, Message: `Expected Valid JSON: "bad"`, ExitCode: ExitInvalidInput},
+		},
+		{
+			err: fmt.Errorf("upload: %w", quotaError{limit: 3}),
+			expected: ErrorEnvelope{
+				Code:     "quota",
+				Message:  "upload: quota of 3 reached",
+				Details:  map[string]int{"limit": 3},
+				ExitCode: 75,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test.expected.Function = "Upload"
+
+		if envelope := NewErrorEnvelope("Upload", test.err); !reflect.DeepEqual(envelope, test.expected) {
+			t.Errorf("envelope of %q is %+v, expected %+v", test.err, envelope, test.expected)
+		}
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	envelope := NewErrorEnvelope("Upload", quotaError{limit: 3})
+
+	var out strings.Builder
+	if err := WriteError(&out, "json", envelope); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"code":"quota","message":"quota of 3 reached","details":{"limit":3},"function":"Upload","retryable":false,"exit_code":75}` + "\n"
+	if out.String() != expected {
+		t.Errorf("json error %q, expected %q", out.String(), expected)
+	}
+
+	if err := WriteError(&out, "yaml", envelope); err == nil {
+		t.Error("WriteError accepted an unknown format")
+	}
+}
+
+func ExampleWriteError() {
+	WriteError(os.Stdout, "text", NewErrorEnvelope("Greet", errors.New("nobody to greet")))
+	WriteError(os.Stdout, "text", NewErrorEnvelope("Upload", quotaError{limit: 3}))
+
+	// Output:
+	// Greet: nobody to greet (code: error, exitCode: 1)
+	// Upload: quota of 3 reached (code: quota, exitCode: 75) {"limit":3}
+}
+
+func TestNewResultEnvelope(t *testing.T) {
+	failure := NewErrorEnvelope("Greet", errors.New("nobody"))
+
+	for output, expected := range map[string]string{
+		"":                   `{"function":"Greet","result":null,"error":null,"duration":"1.5s"}`,
+		" {\"a\": [1, 2]}\n": `{"function":"Greet","result":{"a":[1,2]},"error":null,"duration":"1.5s"}`,
+		"hello bat\n":        `{"function":"Greet","result":"hello bat\n","error":null,"duration":"1.5s"}`,
+		"42":                 `{"function":"Greet","result":42,"error":null,"duration":"1.5s"}`,
+	} {
+		data, err := json.Marshal(NewResultEnvelope("Greet", []byte(output), nil, 1500*time.Millisecond))
+		if err != nil {
+			t.Fatalf("envelope of %q can not be encoded: %s", output, err)
+		}
+
+		if string(data) != expected {
+			t.Errorf("envelope of %q is %s, expected %s", output, data, expected)
+		}
+	}
+
+	result := NewResultEnvelope("Greet", []byte("partial"), &failure, 0)
+	if result.Error == nil || result.Error.Message != "nobody" || string(result.Result) != `"partial"` {
+		t.Errorf("failed result is %+v", result)
+	}
+}
@@ -0,0 +1,60 @@
+package flow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/influx6/faux/exec"
+	"github.com/influx6/faux/metrics"
+)
+
+// ExecInvoker returns an Invoker which executes calls by running the call's
+// binary found within binDir. Any deadline set on the context is delivered
+// to the binary through its `-t` flag.
+func ExecInvoker(binDir string, events metrics.Metrics) Invoker {
+	if events == nil {
+		events = metrics.New()
+	}
+
+	return func(ctx context.Context, call Call, input io.Reader, output io.Writer) error {
+		if call.Bin == "" {
+			return fmt.Errorf("Function %q has no binary to execute", call.Fn)
+		}
+
+		command := []string{filepath.Join(binDir, call.Bin)}
+
+		if deadline, ok := ctx.Deadline(); ok {
+			command = append(command, fmt.Sprintf("-t=%s", time.Until(deadline)))
+		}
+
+		command = append(command, call.Fn)
+		command = append(command, call.Args...)
+		command = append(command, call.Flags...)
+
+		var errs bytes.Buffer
+		if err := exec.New(
+			exec.Async(),
+			exec.Commands(command...),
+			exec.Input(input),
+			exec.Output(output),
+			exec.Err(&errs),
+		).Exec(ctx, events); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			if errs.Len() == 0 {
+				return err
+			}
+
+			return fmt.Errorf("%s: %s", err, strings.TrimSpace(errs.String()))
+		}
+
+		return nil
+	}
+}
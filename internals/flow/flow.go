@@ -0,0 +1,507 @@
+// Package flow implements a declarative runner which executes shogun functions
+// as steps described within a flow file, with support for conditions, retries,
+// timeouts, compensating functions and resumption of failed runs.
+package flow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/influx6/faux/metrics"
+	"github.com/influx6/shogun/internals/trace"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// errors.
+var (
+	ErrNoSteps     = errors.New("Flow has no steps")
+	ErrInvalidWhen = errors.New("Step condition must evaluate to true or false")
+)
+
+// Status defines the state of an executed step.
+type Status string
+
+// const of step status.
+const (
+	Succeeded   Status = "succeeded"
+	Failed      Status = "failed"
+	Skipped     Status = "skipped"
+	Compensated Status = "compensated"
+)
+
+// Call defines the details of a function call made by a step or by its
+// compensating function.
+//
+// Only one of Input, Template or File should be provided, where Input holds
+// a literal value delivered as JSON (or as is if a string), Template holds a
+// text/template rendered against the results of earlier steps and File points
+// to a file whose content is delivered as input.
+type Call struct {
+	Bin      string      `yaml:"bin" json:"bin,omitempty"`
+	Fn       string      `yaml:"fn" json:"fn"`
+	Args     []string    `yaml:"args" json:"args,omitempty"`
+	Flags    []string    `yaml:"flags" json:"flags,omitempty"`
+	Input    interface{} `yaml:"input" json:"input,omitempty"`
+	Template string      `yaml:"template" json:"template,omitempty"`
+	File     string      `yaml:"file" json:"file,omitempty"`
+}
+
+// Retry defines the number of attempts a step has before being marked as failed
+// and the initial backoff duration which doubles after each failed attempt.
+type Retry struct {
+	Attempts int    `yaml:"attempts" json:"attempts"`
+	Backoff  string `yaml:"backoff" json:"backoff"`
+}
+
+// Step defines a single unit of execution within a Flow.
+type Step struct {
+	Call      `yaml:",inline"`
+	Name      string `yaml:"name" json:"name"`
+	When      string `yaml:"when" json:"when,omitempty"`
+	Timeout   string `yaml:"timeout" json:"timeout,omitempty"`
+	Retry     Retry  `yaml:"retry" json:"retry"`
+	OnFailure *Call  `yaml:"on_failure" json:"on_failure,omitempty"`
+}
+
+// Flow defines a series of steps loaded from a flow file.
+type Flow struct {
+	Name  string            `yaml:"name" json:"name"`
+	Vars  map[string]string `yaml:"vars" json:"vars,omitempty"`
+	Steps []Step            `yaml:"steps" json:"steps"`
+
+	// Dir is the directory of the flow file and is used to resolve relative
+	// file inputs.
+	Dir string `yaml:"-" json:"-"`
+}
+
+// Load reads and validates the flow file at the giving path. JSON flow files
+// are equally supported as they are valid YAML documents.
+func Load(file string) (Flow, error) {
+	var fl Flow
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return fl, err
+	}
+
+	if err := yaml.Unmarshal(content, &fl); err != nil {
+		return fl, fmt.Errorf("Invalid flow file %q: %+q", file, err)
+	}
+
+	if len(fl.Steps) == 0 {
+		return fl, ErrNoSteps
+	}
+
+	fl.Dir = filepath.Dir(file)
+
+	if fl.Name == "" {
+		base := filepath.Base(file)
+		fl.Name = strings.TrimSuffix(strings.TrimSuffix(base, filepath.Ext(base)), ".flow")
+	}
+
+	seen := make(map[string]bool)
+	for index := range fl.Steps {
+		step := &fl.Steps[index]
+		if step.Fn == "" {
+			return fl, fmt.Errorf("Step %d of flow %q has no function", index, fl.Name)
+		}
+
+		if step.Name == "" {
+			step.Name = fmt.Sprintf("%d-%s", index, step.Fn)
+		}
+
+		if seen[step.Name] {
+			return fl, fmt.Errorf("Step name %q is used more than once in flow %q", step.Name, fl.Name)
+		}
+
+		seen[step.Name] = true
+
+		if step.Timeout != "" {
+			if _, err := time.ParseDuration(step.Timeout); err != nil {
+				return fl, fmt.Errorf("Step %q has invalid timeout: %+q", step.Name, err)
+			}
+		}
+
+		if step.Retry.Backoff != "" {
+			if _, err := time.ParseDuration(step.Retry.Backoff); err != nil {
+				return fl, fmt.Errorf("Step %q has invalid retry backoff: %+q", step.Name, err)
+			}
+		}
+
+		if step.OnFailure != nil && step.OnFailure.Fn == "" {
+			return fl, fmt.Errorf("Step %q has on_failure without a function", step.Name)
+		}
+	}
+
+	return fl, nil
+}
+
+// Result holds the outcome of an executed step.
+type Result struct {
+	Name         string    `json:"name"`
+	Status       Status    `json:"status"`
+	Output       string    `json:"output"`
+	Error        string    `json:"error,omitempty"`
+	Attempts     int       `json:"attempts"`
+	Started      time.Time `json:"started"`
+	Ended        time.Time `json:"ended"`
+	Compensation *Result   `json:"compensation,omitempty"`
+}
+
+// State holds the results of all executed steps of a flow and is persisted
+// after every step to allow resumption.
+type State struct {
+	Flow    string            `json:"flow"`
+	Updated time.Time         `json:"updated"`
+	Steps   map[string]Result `json:"steps"`
+}
+
+// Scope defines the data made available to step templates and conditions.
+type Scope struct {
+	Vars  map[string]string
+	Env   map[string]string
+	Steps map[string]Result
+}
+
+// Invoker defines a function which executes the giving call with the provided
+// input, writing the function's response into output. The context carries the
+// step's timeout if any.
+type Invoker func(ctx context.Context, call Call, input io.Reader, output io.Writer) error
+
+// Runner executes the steps of a Flow through its Invoker.
+type Runner struct {
+	Invoke    Invoker
+	StateFile string
+	Resume    bool
+	Metrics   metrics.Metrics
+}
+
+// StateFileFor returns the default state file path for giving flow within dir.
+func StateFileFor(dir string, fl Flow) string {
+	return filepath.Join(dir, ".shogun", "flows", fmt.Sprintf("%s.state.json", fl.Name))
+}
+
+// Run executes all steps of the flow in order, returning the final state of the
+// run. Execution stops at the first step which fails after its retries are
+// exhausted, after running its compensating function if any.
+func (r Runner) Run(ctx context.Context, fl Flow) (State, error) {
+	events := r.Metrics
+	if events == nil {
+		events = metrics.New()
+	}
+
+	state := State{Flow: fl.Name, Steps: make(map[string]Result)}
+
+	if r.Resume && r.StateFile != "" {
+		prev, err := readState(r.StateFile)
+		if err != nil && !os.IsNotExist(err) {
+			return state, err
+		}
+
+		if err == nil && prev.Flow == fl.Name {
+			state = prev
+		}
+	}
+
+	scope := Scope{
+		Vars:  fl.Vars,
+		Env:   environ(),
+		Steps: state.Steps,
+	}
+
+	for _, step := range fl.Steps {
+		// Skipped steps are evaluated again, as their conditions may depend on what changed since.
+		if prev, ok := state.Steps[step.Name]; ok && prev.Status == Succeeded {
+			events.Emit(metrics.Info("Skipping completed step"), metrics.With("flow", fl.Name), metrics.With("step", step.Name))
+			continue
+		}
+
+		run, err := evaluate(step.When, scope)
+		if err != nil {
+			return state, fmt.Errorf("Step %q condition failed: %+q", step.Name, err)
+		}
+
+		if !run {
+			state.Steps[step.Name] = Result{Name: step.Name, Status: Skipped, Started: time.Now(), Ended: time.Now()}
+			if err := r.save(&state); err != nil {
+				return state, err
+			}
+
+			continue
+		}
+
+		res := r.runStep(ctx, fl, step, scope, events)
+		state.Steps[step.Name] = res
+
+		if err := r.save(&state); err != nil {
+			return state, err
+		}
+
+		if res.Status != Succeeded {
+			return state, fmt.Errorf("Step %q failed after %d attempt(s): %s", step.Name, res.Attempts, res.Error)
+		}
+	}
+
+	return state, nil
+}
+
+func (r Runner) runStep(ctx context.Context, fl Flow, step Step, scope Scope, events metrics.Metrics) Result {
+	res := Result{Name: step.Name, Started: time.Now()}
+
+	ctx, span := trace.Start(ctx, "step "+step.Name)
+	defer func() {
+		span.Set("attempts", res.Attempts)
+		if res.Error != "" {
+			span.Fail(errors.New(res.Error))
+		}
+
+		span.End()
+	}()
+
+	attempts := step.Retry.Attempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	backoff := time.Second
+	if step.Retry.Backoff != "" {
+		backoff, _ = time.ParseDuration(step.Retry.Backoff)
+	}
+
+	var timeout time.Duration
+	if step.Timeout != "" {
+		timeout, _ = time.ParseDuration(step.Timeout)
+	}
+
+	for res.Attempts < attempts {
+		if res.Attempts > 0 {
+			events.Emit(metrics.Info("Retrying step"), metrics.With("flow", fl.Name), metrics.With("step", step.Name), metrics.With("backoff", backoff.String()))
+
+			select {
+			case <-ctx.Done():
+				res.Status = Failed
+				res.Error = ctx.Err().Error()
+				res.Ended = time.Now()
+				return res
+			case <-time.After(backoff):
+				backoff *= 2
+			}
+		}
+
+		res.Attempts++
+
+		output, err := r.call(ctx, fl, step.Call, scope, timeout)
+		res.Output = output
+		if err == nil {
+			res.Error = ""
+			res.Status = Succeeded
+			res.Ended = time.Now()
+			return res
+		}
+
+		res.Error = err.Error()
+		events.Emit(metrics.Errorf("Step failed: %+q", err), metrics.With("flow", fl.Name), metrics.With("step", step.Name), metrics.With("attempt", res.Attempts))
+	}
+
+	res.Status = Failed
+	res.Ended = time.Now()
+
+	if step.OnFailure == nil {
+		return res
+	}
+
+	// Expose the failed step to the compensating function's template.
+	steps := make(map[string]Result, len(scope.Steps)+1)
+	for name, item := range scope.Steps {
+		steps[name] = item
+	}
+
+	steps[step.Name] = res
+	scope.Steps = steps
+
+	comp := Result{Name: step.Name + ":on_failure", Started: time.Now(), Attempts: 1}
+	output, err := r.call(ctx, fl, *step.OnFailure, scope, timeout)
+	comp.Output = output
+	comp.Ended = time.Now()
+
+	if err != nil {
+		comp.Status = Failed
+		comp.Error = err.Error()
+	} else {
+		comp.Status = Compensated
+	}
+
+	res.Compensation = &comp
+	return res
+}
+
+func (r Runner) call(ctx context.Context, fl Flow, call Call, scope Scope, timeout time.Duration) (string, error) {
+	input, err := buildInput(fl, call, scope)
+	if err != nil {
+		return "", err
+	}
+
+	callCtx := ctx
+	if timeout > 0 {
+		var canceller func()
+		callCtx, canceller = context.WithTimeout(ctx, timeout)
+		defer canceller()
+	}
+
+	var output bytes.Buffer
+	err = r.Invoke(callCtx, call, bytes.NewReader(input), &output)
+	return output.String(), err
+}
+
+func (r Runner) save(state *State) error {
+	state.Updated = time.Now()
+
+	if r.StateFile == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.StateFile), 0700); err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.StateFile, content, 0600)
+}
+
+func readState(file string) (State, error) {
+	var state State
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return state, err
+	}
+
+	if err := json.Unmarshal(content, &state); err != nil {
+		return state, fmt.Errorf("Invalid flow state file %q: %+q", file, err)
+	}
+
+	if state.Steps == nil {
+		state.Steps = make(map[string]Result)
+	}
+
+	return state, nil
+}
+
+// buildInput returns the input for giving call from its literal, template or file.
+func buildInput(fl Flow, call Call, scope Scope) ([]byte, error) {
+	switch {
+	case call.Template != "":
+		rendered, err := render(call.Template, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(rendered), nil
+	case call.File != "":
+		file := call.File
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(fl.Dir, file)
+		}
+
+		return os.ReadFile(file)
+	case call.Input != nil:
+		if text, ok := call.Input.(string); ok {
+			return []byte(text), nil
+		}
+
+		return json.Marshal(jsonable(call.Input))
+	}
+
+	return nil, nil
+}
+
+// evaluate renders giving condition, returning true if it is empty.
+func evaluate(when string, scope Scope) (bool, error) {
+	if strings.TrimSpace(when) == "" {
+		return true, nil
+	}
+
+	rendered, err := render(when, scope)
+	if err != nil {
+		return false, err
+	}
+
+	ok, err := strconv.ParseBool(strings.TrimSpace(rendered))
+	if err != nil {
+		return false, ErrInvalidWhen
+	}
+
+	return ok, nil
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(content string) (interface{}, error) {
+		var data interface{}
+		err := json.Unmarshal([]byte(content), &data)
+		return data, err
+	},
+	"toJSON": func(data interface{}) (string, error) {
+		content, err := json.Marshal(data)
+		return string(content), err
+	},
+}
+
+func render(text string, scope Scope) (string, error) {
+	tml, err := template.New("flow").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	if err := tml.Execute(&out, scope); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
+// jsonable converts maps decoded by yaml into types encoding/json can marshal.
+func jsonable(value interface{}) interface{} {
+	switch item := value.(type) {
+	case map[interface{}]interface{}:
+		mapped := make(map[string]interface{}, len(item))
+		for key, val := range item {
+			mapped[fmt.Sprintf("%v", key)] = jsonable(val)
+		}
+
+		return mapped
+	case []interface{}:
+		for index, val := range item {
+			item[index] = jsonable(val)
+		}
+
+		return item
+	}
+
+	return value
+}
+
+func environ() map[string]string {
+	env := make(map[string]string)
+	for _, pair := range os.Environ() {
+		if index := strings.Index(pair, "="); index != -1 {
+			env[pair[:index]] = pair[index+1:]
+		}
+	}
+
+	return env
+}
@@ -0,0 +1,210 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestBuildInput(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "input.json"), []byte(`{"file":true}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	fl := Flow{Dir: dir}
+	scope := Scope{
+		Vars: map[string]string{"env": "staging"},
+		Env:  map[string]string{"USER": "bat"},
+		Steps: map[string]Result{
+			"build": {Name: "build", Status: Succeeded, Output: "{\"image\":\"app:v1\"}\n"},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		call  Call
+		input string
+		err   bool
+	}{
+		{
+			name:  "field of a JSON output",
+			call:  Call{Template: `{"image": {{ toJSON (json .Steps.build.Output).image }}}`},
+			input: `{"image": "app:v1"}`,
+		},
+		{
+			name:  "whole output encoded as a string",
+			call:  Call{Template: `{{ toJSON .Steps.build.Output }}`},
+			input: `"{\"image\":\"app:v1\"}\n"`,
+		},
+		{
+			name:  "vars and env",
+			call:  Call{Template: `{{ .Vars.env }} {{ .Env.USER }}`},
+			input: `staging bat`,
+		},
+		{
+			name: "missing step",
+			call: Call{Template: `{{ .Steps.push.Output }}`},
+			err:  true,
+		},
+		{
+			name: "invalid JSON output",
+			call: Call{Template: `{{ (json .Vars.env).image }}`},
+			err:  true,
+		},
+		{
+			name:  "literal object",
+			call:  Call{Input: map[interface{}]interface{}{"tag": "v1"}},
+			input: `{"tag":"v1"}`,
+		},
+		{
+			name:  "literal string",
+			call:  Call{Input: "raw"},
+			input: `raw`,
+		},
+		{
+			name:  "file relative to the flow",
+			call:  Call{File: "input.json"},
+			input: `{"file":true}`,
+		},
+		{
+			name:  "none",
+			call:  Call{},
+			input: ``,
+		},
+	}
+
+	for _, test := range tests {
+		input, err := buildInput(fl, test.call, scope)
+
+		switch {
+		case test.err && err == nil:
+			t.Errorf("%s: buildInput returned %q, expected an error", test.name, input)
+		case !test.err && err != nil:
+			t.Errorf("%s: buildInput failed: %s", test.name, err)
+		case !test.err && string(input) != test.input:
+			t.Errorf("%s: buildInput returned %q, expected %q", test.name, input, test.input)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	scope := Scope{Vars: map[string]string{"env": "staging"}}
+
+	tests := []struct {
+		when string
+		run  bool
+		err  error
+	}{
+		{when: "", run: true},
+		{when: `{{ eq .Vars.env "staging" }}`, run: true},
+		{when: `{{ eq .Vars.env "production" }}`, run: false},
+		{when: ` true `, run: true},
+		{when: `{{ .Vars.env }}`, err: ErrInvalidWhen},
+	}
+
+	for _, test := range tests {
+		run, err := evaluate(test.when, scope)
+		if err != test.err || run != test.run {
+			t.Errorf("evaluate(%q) returned %t, %v, expected %t, %v", test.when, run, err, test.run, test.err)
+		}
+	}
+}
+
+func TestRunResume(t *testing.T) {
+	fl := Flow{
+		Name: "deploy",
+		Steps: []Step{
+			{Name: "build", Call: Call{Fn: "build"}},
+			{Name: "gate", Call: Call{Fn: "gate"}, When: `{{ eq .Env.SHOGUN_FLOW_TEST_GATE "open" }}`},
+			{Name: "push", Call: Call{Fn: "push", Template: `{{ .Steps.build.Output }}`}},
+		},
+	}
+
+	var calls []string
+	failPush := true
+
+	runner := Runner{
+		StateFile: filepath.Join(t.TempDir(), "deploy.state.json"),
+		Invoke: func(ctx context.Context, call Call, input io.Reader, output io.Writer) error {
+			data, _ := io.ReadAll(input)
+			calls = append(calls, fmt.Sprintf("%s(%s)", call.Fn, data))
+
+			if call.Fn == "push" && failPush {
+				return errors.New("registry unavailable")
+			}
+
+			fmt.Fprintf(output, "%s-done", call.Fn)
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name     string
+		gate     string
+		failPush bool
+		resume   bool
+		calls    []string
+		statuses map[string]Status
+		err      bool
+	}{
+		{
+			name:     "first run skips the gate and fails to push",
+			gate:     "closed",
+			failPush: true,
+			calls:    []string{"build()", "push(build-done)"},
+			statuses: map[string]Status{"build": Succeeded, "gate": Skipped, "push": Failed},
+			err:      true,
+		},
+		{
+			name:     "resume evaluates the skipped gate again and keeps the built step",
+			gate:     "open",
+			resume:   true,
+			calls:    []string{"gate()", "push(build-done)"},
+			statuses: map[string]Status{"build": Succeeded, "gate": Succeeded, "push": Succeeded},
+		},
+		{
+			name:     "resume of a finished flow runs nothing",
+			gate:     "closed",
+			resume:   true,
+			calls:    nil,
+			statuses: map[string]Status{"build": Succeeded, "gate": Succeeded, "push": Succeeded},
+		},
+		{
+			name:     "without resume every step runs again",
+			gate:     "closed",
+			calls:    []string{"build()", "push(build-done)"},
+			statuses: map[string]Status{"build": Succeeded, "gate": Skipped, "push": Succeeded},
+		},
+	}
+
+	for _, test := range tests {
+		t.Setenv("SHOGUN_FLOW_TEST_GATE", test.gate)
+
+		calls, failPush = nil, test.failPush
+		runner.Resume = test.resume
+
+		state, err := runner.Run(context.Background(), fl)
+		if test.err != (err != nil) {
+			t.Errorf("%s: Run returned error %v", test.name, err)
+		}
+
+		if !reflect.DeepEqual(calls, test.calls) {
+			t.Errorf("%s: Run made calls %q, expected %q", test.name, calls, test.calls)
+		}
+
+		statuses := make(map[string]Status, len(state.Steps))
+		for name, res := range state.Steps {
+			statuses[name] = res.Status
+		}
+
+		if !reflect.DeepEqual(statuses, test.statuses) {
+			t.Errorf("%s: Run ended with %v, expected %v", test.name, statuses, test.statuses)
+		}
+	}
+}
@@ -2,14 +2,31 @@ package internals
 
 import (
 	"os"
+	"path/filepath"
 	"strings"
 	"text/template"
+	"time"
 )
 
 const (
 	spaceLen = 7
 )
 
+// ReservedNames contains the commands of generated binaries, which functions
+// and sub-packages can not be named after without being shadowed by them.
+var ReservedNames = []string{"help", "cgi", "watch", "serve", "ui", "mcp", "lambda", "worker", "daemon", "schedule", "flow"}
+
+// IsReserved returns true/false if giving name is one of ReservedNames.
+func IsReserved(name string) bool {
+	for _, reserved := range ReservedNames {
+		if strings.EqualFold(name, reserved) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // FlagType defines a int type represent the type of flag a function wants.
 type FlagType int
 
@@ -163,6 +180,17 @@ func (f ArgType) Int() int {
 	return int(f)
 }
 
+// ReadsInput returns true/false if functions of the type read their argument from input.
+func (f ArgType) ReadsInput() bool {
+	switch f {
+	case NoArgument, WithContextArgument, WithWriteCloserArgument,
+		WithStringSliceArgument, WithStringSliceArgumentAndWriteCloserArgument:
+		return false
+	}
+
+	return true
+}
+
 // const for input state.
 const (
 	NoArgument                                    ArgType = iota + 1 // is func()
@@ -254,14 +282,103 @@ var (
 
 // ShogunFunc defines a type which contains a function definition details.
 type ShogunFunc struct {
-	NS       string      `json:"ns"`
-	Type     ArgType     `json:"type"`
-	Return   ReturnType  `json:"return"`
-	Context  ContextType `json:"context"`
-	Name     string      `json:"name"`
-	Source   string      `json:"source"`
-	Flags    Flags       `json:"flags"`
-	Function interface{} `json:"-"`
+	NS       string        `json:"ns"`
+	Type     ArgType       `json:"type"`
+	Return   ReturnType    `json:"return"`
+	Context  ContextType   `json:"context"`
+	Name     string        `json:"name"`
+	Binary   string        `json:"binary"`
+	Desc     string        `json:"desc,omitempty"`
+	Source   string        `json:"source"`
+	Flags    Flags         `json:"flags"`
+	Watches  []Watch       `json:"watches,omitempty"`
+	Crons    []Cron        `json:"crons,omitempty"`
+	Timeout  time.Duration `json:"timeout,omitempty"`
+	Retry    *Retry        `json:"retry,omitempty"`
+	Function interface{}   `json:"-"`
+}
+
+// Doc returns the doc comment of the function without its annotations.
+func (f ShogunFunc) Doc() string {
+	var lines []string
+	for _, line := range strings.Split(f.Desc, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "@") {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// Watch contains details of a @watch annotation which declares files whose changes
+// should trigger a function, where Dir is the directory of the function's package
+// against which a relative Path is resolved.
+type Watch struct {
+	Path     string        `json:"path"`
+	Glob     string        `json:"glob,omitempty"`
+	Dir      string        `json:"dir,omitempty"`
+	Debounce time.Duration `json:"debounce"`
+}
+
+// Root returns the path watched by the annotation, resolving it against Dir if it's relative.
+func (w Watch) Root() string {
+	if filepath.IsAbs(w.Path) || w.Dir == "" {
+		return w.Path
+	}
+
+	return filepath.Join(w.Dir, w.Path)
+}
+
+// Cron contains details of a @cron annotation which declares the schedule
+// on which a function should be called.
+type Cron struct {
+	Expr     string        `json:"expr"`
+	Overlap  string        `json:"overlap,omitempty"`
+	Jitter   time.Duration `json:"jitter,omitempty"`
+	Location string        `json:"tz,omitempty"`
+}
+
+// consts of retry backoff strategies and conditions.
+const (
+	BackoffConstant    = "constant"
+	BackoffLinear      = "linear"
+	BackoffExponential = "exponential"
+
+	RetryOnRetryable = "retryable"
+	RetryOnAny       = "any"
+)
+
+// Retry contains details of a @retry annotation which declares how failing calls
+// of a function are retried.
+type Retry struct {
+	Attempts int           `json:"attempts"`
+	Backoff  string        `json:"backoff"`
+	Initial  time.Duration `json:"initial"`
+	Max      time.Duration `json:"max,omitempty"`
+	On       string        `json:"on"`
+}
+
+// Delay returns the duration to wait before giving attempt, which starts from 2.
+func (r Retry) Delay(attempt int) time.Duration {
+	var delay time.Duration
+
+	switch r.Backoff {
+	case BackoffConstant:
+		delay = r.Initial
+	case BackoffLinear:
+		delay = r.Initial * time.Duration(attempt-1)
+	default:
+		delay = r.Initial
+		for index := 2; index < attempt && (r.Max == 0 || delay < r.Max); index++ {
+			delay *= 2
+		}
+	}
+
+	if r.Max != 0 && delay > r.Max {
+		return r.Max
+	}
+
+	return delay
 }
 
 // Flag contains details related to a provided flag.
@@ -342,8 +459,13 @@ type Function struct {
 	HelpMessageWithSource string
 	Depends               []string
 	Flags                 Flags
+	Watches               []Watch
+	Crons                 []Cron
+	Timeout               time.Duration
+	Retry                 *Retry
 	Imports               VarMeta
 	ContextImport         VarMeta
+	Provided              []VarMeta
 }
 
 // PackageFunctions holds a package level function with it's path and name.
@@ -356,6 +478,20 @@ type PackageFunctions struct {
 	BinaryName string
 	MaxNameLen int
 	List       []Function
+	Hooks      []Hook
+	Providers  []Provider
+}
+
+// HooksFor returns the hooks of giving kind, like `@before`.
+func (pn PackageFunctions) HooksFor(kind string) []Hook {
+	var hooks []Hook
+	for _, hook := range pn.Hooks {
+		if hook.Kind == kind {
+			hooks = append(hooks, hook)
+		}
+	}
+
+	return hooks
 }
 
 // Default returns the function set has default for when the execution is called.
@@ -405,6 +541,14 @@ func (pn PackageFunctions) Imports() map[string]string {
 		}
 	}
 
+	for _, provider := range pn.Providers {
+		for _, item := range provider.Imports {
+			if _, ok := mo[item.Import]; item.Import != "" && !ok {
+				mo[item.Import] = item.ImportNick
+			}
+		}
+	}
+
 	return mo
 }
 
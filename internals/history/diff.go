@@ -0,0 +1,93 @@
+package history
+
+import (
+	"bytes"
+	"strings"
+)
+
+// MaxDiffCells limits the size of the table used by Diff, which is the product of
+// the counts of lines which differ between both sides.
+const MaxDiffCells = 1 << 22
+
+// Diff returns the line by line difference between old and new, where removed
+// lines start with "-", added lines with "+" and unchanged lines with a space.
+// It returns false when the lines which differ would need a table larger than
+// MaxDiffCells, in which case only their digests can be compared.
+func Diff(old, new []byte) (string, bool) {
+	oldLines := splitLines(old)
+	newLines := splitLines(new)
+
+	// Lines shared at the start and end are left out of the table.
+	var prefix int
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+
+	var suffix int
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix && oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	oldMiddle := oldLines[prefix : len(oldLines)-suffix]
+	newMiddle := newLines[prefix : len(newLines)-suffix]
+
+	width := len(newMiddle) + 1
+	if (len(oldMiddle)+1)*width > MaxDiffCells {
+		return "", false
+	}
+
+	// lengths[i*width+j] holds the length of the longest common subsequence of
+	// oldMiddle[i:] and newMiddle[j:].
+	lengths := make([]int, (len(oldMiddle)+1)*width)
+	at := func(i, j int) int { return lengths[i*width+j] }
+
+	for i := len(oldMiddle) - 1; i >= 0; i-- {
+		for j := len(newMiddle) - 1; j >= 0; j-- {
+			if oldMiddle[i] == newMiddle[j] {
+				lengths[i*width+j] = at(i+1, j+1) + 1
+			} else if at(i+1, j) >= at(i, j+1) {
+				lengths[i*width+j] = at(i+1, j)
+			} else {
+				lengths[i*width+j] = at(i, j+1)
+			}
+		}
+	}
+
+	var out strings.Builder
+
+	for _, line := range oldLines[:prefix] {
+		out.WriteString("  " + line + "\n")
+	}
+
+	i, j := 0, 0
+	for i < len(oldMiddle) || j < len(newMiddle) {
+		switch {
+		case i < len(oldMiddle) && j < len(newMiddle) && oldMiddle[i] == newMiddle[j]:
+			out.WriteString("  " + oldMiddle[i] + "\n")
+			i++
+			j++
+		case i < len(oldMiddle) && (j == len(newMiddle) || at(i+1, j) >= at(i, j+1)):
+			out.WriteString("- " + oldMiddle[i] + "\n")
+			i++
+		default:
+			out.WriteString("+ " + newMiddle[j] + "\n")
+			j++
+		}
+	}
+
+	for _, line := range oldLines[len(oldLines)-suffix:] {
+		out.WriteString("  " + line + "\n")
+	}
+
+	return out.String(), true
+}
+
+// splitLines returns the lines of giving data.
+func splitLines(data []byte) []string {
+	data = bytes.TrimSuffix(data, []byte("\n"))
+	if len(data) == 0 {
+		return nil
+	}
+
+	return strings.Split(string(data), "\n")
+}
@@ -0,0 +1,83 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		diff string
+	}{
+		{
+			name: "same",
+			old:  "a\nb\n",
+			new:  "a\nb\n",
+			diff: "  a\n  b\n",
+		},
+		{
+			name: "changed line",
+			old:  "a\nb\nc\n",
+			new:  "a\nx\nc\n",
+			diff: "  a\n- b\n+ x\n  c\n",
+		},
+		{
+			name: "added lines",
+			old:  "a\n",
+			new:  "a\nb\nc\n",
+			diff: "  a\n+ b\n+ c\n",
+		},
+		{
+			name: "removed lines",
+			old:  "a\nb\nc\n",
+			new:  "c\n",
+			diff: "- a\n- b\n  c\n",
+		},
+		{
+			name: "empty old",
+			old:  "",
+			new:  "a\n",
+			diff: "+ a\n",
+		},
+		{
+			name: "missing trailing newline",
+			old:  "a\nb",
+			new:  "a\nb\n",
+			diff: "  a\n  b\n",
+		},
+	}
+
+	for _, test := range tests {
+		diff, ok := Diff([]byte(test.old), []byte(test.new))
+		if !ok {
+			t.Errorf("%s: Diff was too large", test.name)
+			continue
+		}
+
+		if diff != test.diff {
+			t.Errorf("%s: Diff returned %q, expected %q", test.name, diff, test.diff)
+		}
+	}
+}
+
+func TestDiffTooLarge(t *testing.T) {
+	var old, new strings.Builder
+	for index := 0; index < 3000; index++ {
+		fmt.Fprintf(&old, "old %d\n", index)
+		fmt.Fprintf(&new, "new %d\n", index)
+	}
+
+	if _, ok := Diff([]byte(old.String()), []byte(new.String())); ok {
+		t.Errorf("Diff of %d differing lines should exceed MaxDiffCells", 3000)
+	}
+
+	// Lines shared at the start and end are left out of the table.
+	shared := old.String()
+	if _, ok := Diff([]byte(shared+"a\n"+shared), []byte(shared+"b\n"+shared)); !ok {
+		t.Errorf("Diff of a single differing line should not exceed MaxDiffCells")
+	}
+}
@@ -0,0 +1,205 @@
+// Package history records invocations of shogun functions into a local append-only
+// store, from which they can be filtered and replayed.
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// errors.
+var (
+	ErrNotFound = errors.New("history record not found")
+)
+
+// secretNames matches the names of flags and keys whose values are redacted.
+var secretNames = regexp.MustCompile(`(?i)(secret|token|passw(or)?d|pwd|api[_-]?key|private[_-]?key|auth|credential)`)
+
+// redacted replaces the values of secrets within records.
+const redacted = "[REDACTED]"
+
+// Record contains the details of a single invocation.
+type Record struct {
+	ID              string                   `json:"id"`
+	Time            time.Time                `json:"time"`
+	Binary          string                   `json:"binary"`
+	Function        string                   `json:"function"`
+	Args            []string                 `json:"args"`
+	Redacted        bool                     `json:"redacted,omitempty"`
+	Timeout         time.Duration            `json:"timeout,omitempty"`
+	Dir             string                   `json:"dir"`
+	Limit           int                      `json:"limit,omitempty"`
+	InputDigest     string                   `json:"input_digest"`
+	Input           []byte                   `json:"input,omitempty"`
+	InputTruncated  bool                     `json:"input_truncated,omitempty"`
+	InputOmitted    bool                     `json:"input_omitted,omitempty"`
+	OutputDigest    string                   `json:"output_digest"`
+	Output          []byte                   `json:"output,omitempty"`
+	OutputTruncated bool                     `json:"output_truncated,omitempty"`
+	Duration        time.Duration            `json:"duration"`
+	ExitCode        int                      `json:"exit_code"`
+	Error           *internals.ErrorEnvelope `json:"error,omitempty"`
+}
+
+// Filter sets which records are returned by Read.
+type Filter struct {
+	Binary   string
+	Function string
+	Since    time.Time
+	Failed   bool
+	Limit    int
+}
+
+// Match returns true/false if giving record matches the filter.
+func (f Filter) Match(rec Record) bool {
+	if f.Binary != "" && f.Binary != rec.Binary {
+		return false
+	}
+
+	if f.Function != "" && !strings.EqualFold(f.Function, rec.Function) {
+		return false
+	}
+
+	if !f.Since.IsZero() && rec.Time.Before(f.Since) {
+		return false
+	}
+
+	if f.Failed && rec.ExitCode == 0 {
+		return false
+	}
+
+	return true
+}
+
+// Path returns the file where records are stored, which is `~/.shogun/history.jsonl`
+// unless set by the SHOGUN_HISTORY environment variable.
+func Path() (string, error) {
+	if path := os.Getenv("SHOGUN_HISTORY"); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".shogun", "history.jsonl"), nil
+}
+
+// Append adds giving record as a line to the end of the file at path.
+func Append(path string, rec Record) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	// A single write of the whole line keeps concurrent appends from interleaving.
+	_, err = file.Write(append(data, '\n'))
+	return err
+}
+
+// Read returns the records within the file at path which match giving filter,
+// keeping only the latest when the filter has a limit.
+func Read(path string, filter Filter) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	defer file.Close()
+
+	var records []Record
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+
+	for scanner.Scan() {
+		var rec Record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			continue
+		}
+
+		if filter.Match(rec) {
+			records = append(records, rec)
+		}
+	}
+
+	if filter.Limit > 0 && len(records) > filter.Limit {
+		records = records[len(records)-filter.Limit:]
+	}
+
+	return records, scanner.Err()
+}
+
+// Find returns the record with giving id, which may be a prefix of it.
+func Find(path string, id string) (Record, error) {
+	records, err := Read(path, Filter{})
+	if err != nil {
+		return Record{}, err
+	}
+
+	for index := len(records) - 1; index >= 0; index-- {
+		if strings.HasPrefix(records[index].ID, id) {
+			return records[index], nil
+		}
+	}
+
+	return Record{}, ErrNotFound
+}
+
+// Redact returns a copy of giving arguments with the values of secret flags,
+// like `-token=x` or `--password x`, and secret keys, like `api_key=x`, redacted.
+func Redact(args []string) ([]string, bool) {
+	var changed bool
+
+	redactedArgs := make([]string, len(args))
+	copy(redactedArgs, args)
+
+	for index := 0; index < len(redactedArgs); index++ {
+		arg := redactedArgs[index]
+
+		name := strings.TrimLeft(arg, "-")
+		if eq := strings.IndexByte(name, '='); eq != -1 {
+			if secretNames.MatchString(name[:eq]) {
+				redactedArgs[index] = arg[:len(arg)-len(name)+eq+1] + redacted
+				changed = true
+			}
+
+			continue
+		}
+
+		// Flags with their value as the next argument.
+		if strings.HasPrefix(arg, "-") && secretNames.MatchString(name) && index+1 < len(redactedArgs) {
+			if !strings.HasPrefix(redactedArgs[index+1], "-") {
+				redactedArgs[index+1] = redacted
+				changed = true
+				index++
+			}
+		}
+	}
+
+	return redactedArgs, changed
+}
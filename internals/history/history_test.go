@@ -0,0 +1,69 @@
+package history
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		args     []string
+		redacted []string
+		changed  bool
+	}{
+		{
+			args:     []string{"greet", "--token=x"},
+			redacted: []string{"greet", "--token=[REDACTED]"},
+			changed:  true,
+		},
+		{
+			args:     []string{"greet", "--token", "x"},
+			redacted: []string{"greet", "--token", "[REDACTED]"},
+			changed:  true,
+		},
+		{
+			args:     []string{"greet", "-password", "x", "-v"},
+			redacted: []string{"greet", "-password", "[REDACTED]", "-v"},
+			changed:  true,
+		},
+		{
+			args:     []string{"greet", "-auth-token=a=b"},
+			redacted: []string{"greet", "-auth-token=[REDACTED]"},
+			changed:  true,
+		},
+		{
+			args:     []string{"greet", "api_key=x"},
+			redacted: []string{"greet", "api_key=[REDACTED]"},
+			changed:  true,
+		},
+		{
+			args:     []string{"greet", "--token", "-v"},
+			redacted: []string{"greet", "--token", "-v"},
+		},
+		{
+			args:     []string{"greet", "--token"},
+			redacted: []string{"greet", "--token"},
+		},
+		{
+			args:     []string{"greet", "--name=bat", "bat"},
+			redacted: []string{"greet", "--name=bat", "bat"},
+		},
+	}
+
+	for _, test := range tests {
+		redacted, changed := Redact(test.args)
+
+		if !reflect.DeepEqual(redacted, test.redacted) || changed != test.changed {
+			t.Errorf("Redact(%q) returned %q, %t, expected %q, %t", test.args, redacted, changed, test.redacted, test.changed)
+		}
+	}
+}
+
+func TestRedactKeepsArgs(t *testing.T) {
+	args := []string{"--token", "x"}
+	Redact(args)
+
+	if args[1] != "x" {
+		t.Errorf("Redact changed the given arguments into %q", args)
+	}
+}
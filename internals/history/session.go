@@ -0,0 +1,146 @@
+package history
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"os"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// DefaultLimit sets the number of bytes of input and output kept within a record
+// when no limit is given.
+const DefaultLimit = 64 * 1024
+
+// Capture is an io.Writer which digests all written data, keeping only its
+// beginning up to a limit.
+type Capture struct {
+	limit     int
+	data      []byte
+	truncated bool
+	digest    hash.Hash
+}
+
+// NewCapture returns a new Capture keeping up to limit bytes.
+func NewCapture(limit int) *Capture {
+	return &Capture{limit: limit, digest: sha256.New()}
+}
+
+// Write implements the io.Writer interface.
+func (c *Capture) Write(data []byte) (int, error) {
+	c.digest.Write(data)
+
+	if room := c.limit - len(c.data); room > 0 {
+		if len(data) > room {
+			c.data = append(c.data, data[:room]...)
+			c.truncated = true
+		} else {
+			c.data = append(c.data, data...)
+		}
+	} else if len(data) != 0 {
+		c.truncated = true
+	}
+
+	return len(data), nil
+}
+
+// Bytes returns the kept data.
+func (c *Capture) Bytes() []byte {
+	return c.data
+}
+
+// Truncated returns true/false if more data was written than kept.
+func (c *Capture) Truncated() bool {
+	return c.truncated
+}
+
+// Digest returns the hex encoded sha256 digest of all written data.
+func (c *Capture) Digest() string {
+	return "sha256:" + hex.EncodeToString(c.digest.Sum(nil))
+}
+
+// Session records a single invocation, capturing its input and output.
+type Session struct {
+	rec       Record
+	started   time.Time
+	withInput bool
+	input     *Capture
+	output    *Capture
+}
+
+// Begin returns a new Session for a call of function through binary with giving
+// arguments, keeping up to limit bytes of its output. Its input is only kept, up
+// to the same limit, if withInput is true, as it may hold secrets, though its
+// digest is always recorded.
+func Begin(binary string, function string, args []string, timeout time.Duration, limit int, withInput bool) *Session {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	inputLimit := limit
+	if !withInput {
+		inputLimit = 0
+	}
+
+	dir, _ := os.Getwd()
+	redactedArgs, changed := Redact(args)
+
+	return &Session{
+		started:   time.Now(),
+		withInput: withInput,
+		input:     NewCapture(inputLimit),
+		output:    NewCapture(limit),
+		rec: Record{
+			ID:       internals.NewInvocationID(),
+			Dir:      dir,
+			Binary:   binary,
+			Function: function,
+			Timeout:  timeout,
+			Limit:    limit,
+			Args:     redactedArgs,
+			Redacted: changed,
+		},
+	}
+}
+
+// ID returns the ID of the invocation's record.
+func (s *Session) ID() string {
+	return s.rec.ID
+}
+
+// Input returns a reader which captures what is read from r.
+func (s *Session) Input(r io.Reader) io.Reader {
+	return io.TeeReader(r, s.input)
+}
+
+// Output returns a writer which captures what is written to w.
+func (s *Session) Output(w io.Writer) io.Writer {
+	return io.MultiWriter(w, s.output)
+}
+
+// End appends the record of the invocation into the history file, with the
+// envelope of its error if it failed.
+func (s *Session) End(exitCode int, envelope *internals.ErrorEnvelope) error {
+	path, pathErr := Path()
+	if pathErr != nil {
+		return pathErr
+	}
+
+	rec := s.rec
+	rec.Time = s.started
+	rec.Duration = time.Since(s.started)
+	rec.ExitCode = exitCode
+	rec.Input = s.input.Bytes()
+	rec.InputDigest = s.input.Digest()
+	rec.InputTruncated = s.withInput && s.input.Truncated()
+	rec.InputOmitted = !s.withInput && s.input.Truncated()
+	rec.Output = s.output.Bytes()
+	rec.OutputDigest = s.output.Digest()
+	rec.OutputTruncated = s.output.Truncated()
+	rec.Error = envelope
+
+	return Append(path, rec)
+}
@@ -0,0 +1,167 @@
+package internals
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/influx6/shogun/internals/trace"
+)
+
+// consts of hook annotations.
+const (
+	HookBefore   = "@before"
+	HookAfter    = "@after"
+	HookSetup    = "@setup"
+	HookTeardown = "@teardown"
+)
+
+// HookKinds contains the annotations which declare hooks, in the order they run.
+var HookKinds = []string{HookSetup, HookBefore, HookAfter, HookTeardown}
+
+// Hook defines a function declared with a @before, @after, @setup or @teardown
+// annotation, which runs around the functions of its package instead of being
+// a command.
+type Hook struct {
+	Kind     string
+	RealName string
+}
+
+// BeforeHook is run before every function, and may enrich its context or abort its call.
+type BeforeHook func(ctx context.Context, cmd string) (context.Context, error)
+
+// AfterHook is run after every function with the error and duration of its call.
+type AfterHook func(ctx context.Context, cmd string, err error, took time.Duration)
+
+// LifecycleHook is run once per process, either before the first function or before the process ends.
+type LifecycleHook func(ctx context.Context) error
+
+// Hooks runs the hooks of a package around its functions.
+type Hooks struct {
+	Before   []BeforeHook
+	After    []AfterHook
+	Setup    []LifecycleHook
+	Teardown []LifecycleHook
+
+	setup    sync.Once
+	setupErr error
+
+	mu       sync.Mutex
+	started  bool
+	finished bool
+}
+
+// Start runs the setup hooks once, returning their error on every call. They are
+// given a context which is never cancelled, as what they set up outlives the call
+// which started them.
+func (h *Hooks) Start(ctx context.Context) error {
+	h.setup.Do(func() {
+		h.mu.Lock()
+		h.started = true
+		h.mu.Unlock()
+
+		if len(h.Setup) == 0 {
+			return
+		}
+
+		ctx, span := trace.Start(context.WithoutCancel(ctx), "setup hooks")
+		defer span.End()
+
+		span.Set("hooks", len(h.Setup))
+		for _, hook := range h.Setup {
+			if h.setupErr = hook(ctx); h.setupErr != nil {
+				span.Fail(h.setupErr)
+				return
+			}
+		}
+	})
+
+	return h.setupErr
+}
+
+// Stop runs the teardown hooks in reverse order if Start was called, only once.
+func (h *Hooks) Stop(ctx context.Context) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.started || h.finished {
+		return nil
+	}
+
+	h.finished = true
+
+	if len(h.Teardown) == 0 {
+		return nil
+	}
+
+	ctx, span := trace.Start(ctx, "teardown hooks")
+	defer span.End()
+
+	span.Set("hooks", len(h.Teardown))
+
+	var errs []error
+	for index := len(h.Teardown) - 1; index >= 0; index-- {
+		if err := h.Teardown[index](ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := errors.Join(errs...)
+	span.Fail(err)
+	return err
+}
+
+// Around calls fn for giving command after the before hooks, which abort the
+// call by returning an error, then calls the after hooks with its result.
+func (h *Hooks) Around(ctx context.Context, cmd string, fn func(ctx context.Context) error) error {
+	started := time.Now()
+
+	err := h.before(&ctx, cmd)
+	if err == nil {
+		err = fn(ctx)
+	}
+
+	h.after(ctx, cmd, err, time.Since(started))
+	return err
+}
+
+// before runs the before hooks within a span, as the context they return is
+// passed on to the function rather than one carrying the span.
+func (h *Hooks) before(ctx *context.Context, cmd string) error {
+	if len(h.Before) == 0 {
+		return nil
+	}
+
+	_, span := trace.Start(*ctx, "before hooks")
+	defer span.End()
+
+	span.Set("hooks", len(h.Before))
+	for _, hook := range h.Before {
+		next, err := hook(*ctx, cmd)
+		if err != nil {
+			span.Fail(err)
+			return err
+		}
+
+		if next != nil {
+			*ctx = next
+		}
+	}
+
+	return nil
+}
+
+func (h *Hooks) after(ctx context.Context, cmd string, err error, took time.Duration) {
+	if len(h.After) == 0 {
+		return
+	}
+
+	ctx, span := trace.Start(ctx, "after hooks")
+	defer span.End()
+
+	span.Set("hooks", len(h.After))
+	for _, hook := range h.After {
+		hook(ctx, cmd, err, took)
+	}
+}
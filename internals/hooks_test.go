@@ -0,0 +1,191 @@
+package internals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influx6/shogun/internals/trace"
+)
+
+type userKey struct{}
+
+// journal records the hooks and functions run for a test, in order.
+type journal struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (j *journal) add(format string, args ...interface{}) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.entries = append(j.entries, fmt.Sprintf(format, args...))
+}
+
+func (j *journal) expect(t *testing.T, expected ...string) {
+	t.Helper()
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !reflect.DeepEqual(j.entries, expected) {
+		t.Errorf("ran %q, expected %q", j.entries, expected)
+	}
+
+	j.entries = nil
+}
+
+func TestHooksAroundFunctions(t *testing.T) {
+	j := &journal{}
+	denied := errors.New("denied")
+
+	hooks := &Hooks{
+		Before: []BeforeHook{
+			func(ctx context.Context, cmd string) (context.Context, error) {
+				j.add("auth %s", cmd)
+				if cmd == "delete" {
+					return nil, denied
+				}
+
+				return context.WithValue(ctx, userKey{}, "bat"), nil
+			},
+			func(ctx context.Context, cmd string) (context.Context, error) {
+				j.add("audit %s by %v", cmd, ctx.Value(userKey{}))
+				return nil, nil
+			},
+		},
+		After: []AfterHook{
+			func(ctx context.Context, cmd string, err error, took time.Duration) {
+				j.add("after %s by %v: %v", cmd, ctx.Value(userKey{}), err)
+			},
+		},
+	}
+
+	err := hooks.Around(context.Background(), "list", func(ctx context.Context) error {
+		j.add("list by %v", ctx.Value(userKey{}))
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j.expect(t, "auth list", "audit list by bat", "list by bat", "after list by bat: <nil>")
+
+	err = hooks.Around(context.Background(), "delete", func(ctx context.Context) error {
+		j.add("delete")
+		return nil
+	})
+
+	if err != denied {
+		t.Errorf("Around returned %v, expected the error of the before hook", err)
+	}
+
+	j.expect(t, "auth delete", "after delete by <nil>: denied")
+}
+
+func TestLifecycleHooks(t *testing.T) {
+	j := &journal{}
+
+	lifecycle := func(name string, err error) LifecycleHook {
+		return func(ctx context.Context) error {
+			j.add("%s (cancelled: %v)", name, ctx.Err() != nil)
+			return err
+		}
+	}
+
+	t.Run("setup and teardown", func(t *testing.T) {
+		hooks := &Hooks{
+			Setup:    []LifecycleHook{lifecycle("open db", nil), lifecycle("warm cache", nil)},
+			Teardown: []LifecycleHook{lifecycle("close db", errors.New("db busy")), lifecycle("flush cache", errors.New("disk full"))},
+		}
+
+		if err := hooks.Stop(context.Background()); err != nil {
+			t.Errorf("Stop before Start returned %v", err)
+		}
+
+		j.expect(t)
+
+		// Setup hooks outlive the call which started them, so get no cancellation from it.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var wg sync.WaitGroup
+		for index := 0; index < 5; index++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				if err := hooks.Start(ctx); err != nil {
+					t.Errorf("Start returned %v", err)
+				}
+			}()
+		}
+
+		wg.Wait()
+		j.expect(t, "open db (cancelled: false)", "warm cache (cancelled: false)")
+
+		err := hooks.Stop(context.Background())
+		if err == nil || err.Error() != "disk full\ndb busy" {
+			t.Errorf("Stop returned %q, expected the errors of every teardown hook", err)
+		}
+
+		j.expect(t, "flush cache (cancelled: false)", "close db (cancelled: false)")
+
+		if err := hooks.Stop(context.Background()); err != nil {
+			t.Errorf("second Stop returned %v", err)
+		}
+
+		j.expect(t)
+	})
+
+	t.Run("failed setup", func(t *testing.T) {
+		unreachable := errors.New("unreachable")
+		hooks := &Hooks{Setup: []LifecycleHook{lifecycle("connect", unreachable), lifecycle("migrate", nil)}}
+
+		for attempt := 0; attempt < 2; attempt++ {
+			if err := hooks.Start(context.Background()); err != unreachable {
+				t.Errorf("Start returned %v, expected the error of the setup hook", err)
+			}
+		}
+
+		j.expect(t, "connect (cancelled: false)")
+	})
+}
+
+func TestHookSpans(t *testing.T) {
+	tracer := trace.New(1)
+	ctx := trace.With(context.Background(), tracer)
+
+	hooks := &Hooks{
+		Setup:  []LifecycleHook{func(context.Context) error { return nil }},
+		Before: []BeforeHook{func(ctx context.Context, cmd string) (context.Context, error) { return ctx, nil }},
+		After:  []AfterHook{func(context.Context, string, error, time.Duration) {}},
+	}
+
+	hooks.Start(ctx)
+	hooks.Around(ctx, "list", func(ctx context.Context) error {
+		_, span := trace.Start(ctx, "list")
+		span.End()
+		return nil
+	})
+	hooks.Stop(ctx)
+
+	var names []string
+	for _, span := range tracer.Spans() {
+		if span.Parent != 0 {
+			t.Errorf("span %q has parent %d, expected hooks to not nest the function", span.Name, span.Parent)
+		}
+
+		names = append(names, span.Name)
+	}
+
+	if expected := []string{"setup hooks", "before hooks", "list", "after hooks"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("spans %q, expected %q", names, expected)
+	}
+}
@@ -0,0 +1,99 @@
+package internals
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/influx6/shogun/internals/trace"
+)
+
+// Attempter defines a function which makes a single attempt at calling a function,
+// reading its argument from input and bounding its context by timeout if not zero.
+type Attempter func(ctx context.Context, input io.Reader, timeout time.Duration) error
+
+// Invoke calls attempt for giving function. The function's @timeout is used if
+// timeout is zero, and failed attempts are retried as set by its @retry, with
+// each attempt reading the same input and finding its number with Attempt(ctx).
+func Invoke(ctx context.Context, fn ShogunFunc, input io.Reader, timeout time.Duration, attempt Attempter) error {
+	if timeout == 0 {
+		timeout = fn.Timeout
+	}
+
+	if fn.Retry == nil || fn.Retry.Attempts <= 1 {
+		return traced(ctx, 1, input, timeout, attempt)
+	}
+
+	// Buffer input once, so every attempt reads it from the start.
+	var data []byte
+	if fn.Type.ReadsInput() && input != nil {
+		var err error
+		if data, err = io.ReadAll(input); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	for number := 1; number <= fn.Retry.Attempts; number++ {
+		if number > 1 {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(fn.Retry.Delay(number)):
+			}
+		}
+
+		err = traced(WithAttempt(ctx, number), number, bytes.NewReader(data), timeout, attempt)
+		if err == nil || ctx.Err() != nil || !fn.Retry.retries(fn, err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+// traced calls attempt within a span for the attempt with giving number.
+func traced(ctx context.Context, number int, input io.Reader, timeout time.Duration, attempt Attempter) error {
+	ctx, span := trace.Start(ctx, "attempt")
+	defer span.End()
+
+	span.Set("attempt", number)
+
+	err := attempt(ctx, input, timeout)
+	span.Fail(err)
+	return err
+}
+
+// DecodeInput decodes the JSON argument of a function from incoming into v,
+// within an input span.
+func DecodeInput(ctx context.Context, incoming io.Reader, v interface{}) error {
+	_, span := trace.Start(ctx, "input")
+	defer span.End()
+
+	err := json.NewDecoder(incoming).Decode(v)
+	span.Fail(err)
+	return err
+}
+
+// ReadInput copies the string argument of a function from incoming into w,
+// within an input span.
+func ReadInput(ctx context.Context, incoming io.Reader, w io.Writer) (int64, error) {
+	_, span := trace.Start(ctx, "input")
+	defer span.End()
+
+	n, err := io.Copy(w, incoming)
+	span.Set("bytes", n)
+	span.Fail(err)
+	return n, err
+}
+
+// retries returns true/false if giving error of function should be retried.
+func (r Retry) retries(fn ShogunFunc, err error) bool {
+	if r.On == RetryOnAny {
+		return true
+	}
+
+	return NewErrorEnvelope(fn.Name, err).Retryable
+}
@@ -0,0 +1,138 @@
+package internals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	ms := time.Millisecond
+
+	tests := []struct {
+		retry    Retry
+		expected []time.Duration
+	}{
+		{retry: Retry{Backoff: BackoffConstant, Initial: 10 * ms}, expected: []time.Duration{10 * ms, 10 * ms, 10 * ms}},
+		{retry: Retry{Backoff: BackoffLinear, Initial: 10 * ms}, expected: []time.Duration{10 * ms, 20 * ms, 30 * ms}},
+		{retry: Retry{Backoff: BackoffLinear, Initial: 10 * ms, Max: 25 * ms}, expected: []time.Duration{10 * ms, 20 * ms, 25 * ms}},
+		{retry: Retry{Backoff: BackoffExponential, Initial: 10 * ms}, expected: []time.Duration{10 * ms, 20 * ms, 40 * ms, 80 * ms}},
+		{retry: Retry{Initial: 10 * ms, Max: 30 * ms}, expected: []time.Duration{10 * ms, 20 * ms, 30 * ms, 30 * ms}},
+	}
+
+	for _, test := range tests {
+		var delays []time.Duration
+		for attempt := 2; attempt < len(test.expected)+2; attempt++ {
+			delays = append(delays, test.retry.Delay(attempt))
+		}
+
+		if !reflect.DeepEqual(delays, test.expected) {
+			t.Errorf("%+v delays attempts by %v, expected %v", test.retry, delays, test.expected)
+		}
+	}
+}
+
+// attempts records the calls made by Invoke, failing each with the next of errs.
+type attempts struct {
+	errs     []error
+	numbers  []int
+	inputs   []string
+	timeouts []time.Duration
+}
+
+func (a *attempts) attempt(ctx context.Context, input io.Reader, timeout time.Duration) error {
+	data, _ := io.ReadAll(input)
+
+	a.numbers = append(a.numbers, Attempt(ctx))
+	a.inputs = append(a.inputs, string(data))
+	a.timeouts = append(a.timeouts, timeout)
+
+	if len(a.errs) == 0 {
+		return nil
+	}
+
+	err := a.errs[0]
+	a.errs = a.errs[1:]
+	return err
+}
+
+func TestInvoke(t *testing.T) {
+	timedOut := fmt.Errorf("fetch: %w", context.DeadlineExceeded)
+	broken := errors.New("broken")
+
+	fn := ShogunFunc{
+		Name:    "Fetch",
+		Type:    WithStringArgumentAndWriteCloserArgument,
+		Timeout: time.Minute,
+		Retry:   &Retry{Attempts: 3, Backoff: BackoffConstant, Initial: time.Millisecond, On: RetryOnRetryable},
+	}
+
+	t.Run("retryable errors", func(t *testing.T) {
+		a := &attempts{errs: []error{timedOut, timedOut}}
+		if err := Invoke(context.Background(), fn, strings.NewReader("page"), 0, a.attempt); err != nil {
+			t.Fatalf("Invoke returned %v after a successful attempt", err)
+		}
+
+		if !reflect.DeepEqual(a.numbers, []int{1, 2, 3}) || !reflect.DeepEqual(a.inputs, []string{"page", "page", "page"}) {
+			t.Errorf("attempts %v read %q, expected each to read the whole input", a.numbers, a.inputs)
+		}
+
+		if a.timeouts[0] != time.Minute {
+			t.Errorf("attempt was bound by %s, expected the @timeout of the function", a.timeouts[0])
+		}
+	})
+
+	t.Run("attempts run out", func(t *testing.T) {
+		a := &attempts{errs: []error{timedOut, timedOut, timedOut, nil}}
+		if err := Invoke(context.Background(), fn, strings.NewReader("page"), time.Second, a.attempt); err != timedOut {
+			t.Errorf("Invoke returned %v, expected the error of the last attempt", err)
+		}
+
+		if len(a.numbers) != 3 || a.timeouts[0] != time.Second {
+			t.Errorf("%d attempts bound by %s, expected 3 bound by the given timeout", len(a.numbers), a.timeouts[0])
+		}
+	})
+
+	t.Run("errors which are not retryable", func(t *testing.T) {
+		a := &attempts{errs: []error{broken}}
+		if err := Invoke(context.Background(), fn, strings.NewReader("page"), 0, a.attempt); err != broken || len(a.numbers) != 1 {
+			t.Errorf("Invoke returned %v after %d attempts", err, len(a.numbers))
+		}
+
+		always := fn
+		always.Retry = &Retry{Attempts: 2, On: RetryOnAny}
+
+		a = &attempts{errs: []error{broken}}
+		if err := Invoke(context.Background(), always, strings.NewReader("page"), 0, a.attempt); err != nil || len(a.numbers) != 2 {
+			t.Errorf("Invoke of retry on any returned %v after %d attempts", err, len(a.numbers))
+		}
+	})
+
+	t.Run("cancelled while waiting", func(t *testing.T) {
+		slow := fn
+		slow.Retry = &Retry{Attempts: 3, Backoff: BackoffConstant, Initial: time.Hour}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		a := &attempts{errs: []error{timedOut}}
+		if err := Invoke(ctx, slow, strings.NewReader("page"), 0, a.attempt); err != timedOut || len(a.numbers) != 1 {
+			t.Errorf("Invoke returned %v after %d attempts, expected the first error", err, len(a.numbers))
+		}
+	})
+
+	t.Run("without retries", func(t *testing.T) {
+		once := fn
+		once.Retry = nil
+
+		a := &attempts{errs: []error{timedOut}}
+		if err := Invoke(context.Background(), once, strings.NewReader("page"), 0, a.attempt); err != timedOut || len(a.numbers) != 1 {
+			t.Errorf("Invoke returned %v after %d attempts", err, len(a.numbers))
+		}
+	})
+}
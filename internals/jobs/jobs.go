@@ -0,0 +1,420 @@
+// Package jobs runs commands in the background, saving their output and exit
+// status into a directory per job so they can be inspected, waited on or killed
+// by later invocations.
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Status defines the state of a job.
+type Status string
+
+// consts of job statuses.
+const (
+	Running   Status = "running"
+	Succeeded Status = "succeeded"
+	Failed    Status = "failed"
+	Killed    Status = "killed"
+	Lost      Status = "lost"
+)
+
+// consts of file names within a job's directory.
+const (
+	jobFile    = "job.json"
+	stdinFile  = "stdin"
+	stdoutFile = "stdout.log"
+	stderrFile = "stderr.log"
+	killFile   = "killed"
+)
+
+// startTimeout bounds how long a job may wait for its supervisor to start, after
+// which a job without one is lost.
+const startTimeout = 30 * time.Second
+
+// errors.
+var (
+	ErrNotFound   = errors.New("job not found")
+	ErrNotRunning = errors.New("job is not running")
+)
+
+// Job contains the details of a background command.
+type Job struct {
+	ID         string    `json:"id"`
+	Command    []string  `json:"command"`
+	Dir        string    `json:"dir"`
+	Status     Status    `json:"status"`
+	Supervisor int       `json:"supervisor"`
+	PID        int       `json:"pid"`
+	ExitCode   int       `json:"exit_code"`
+	Error      string    `json:"error,omitempty"`
+	Started    time.Time `json:"started"`
+	Ended      time.Time `json:"ended"`
+
+	path string
+}
+
+// Done returns true/false if the job is no longer running.
+func (j Job) Done() bool {
+	return j.Status != Running
+}
+
+// Stdout returns the path of the file holding the job's standard output.
+func (j Job) Stdout() string {
+	return filepath.Join(j.path, stdoutFile)
+}
+
+// Stderr returns the path of the file holding the job's standard error.
+func (j Job) Stderr() string {
+	return filepath.Join(j.path, stderrFile)
+}
+
+// Root returns the directory where jobs are stored, which is `~/.shogun/jobs`.
+func Root() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".shogun", "jobs"), nil
+}
+
+// Create saves a new job for giving command into root, which will run from dir
+// with the content of input as its standard input.
+func Create(root string, command []string, dir string, input io.Reader) (Job, error) {
+	id, err := newID()
+	if err != nil {
+		return Job{}, err
+	}
+
+	job := Job{
+		ID:      id,
+		Dir:     dir,
+		Command: command,
+		Status:  Running,
+		Started: time.Now(),
+		path:    filepath.Join(root, id),
+	}
+
+	if err := os.MkdirAll(job.path, 0700); err != nil {
+		return job, err
+	}
+
+	if input != nil {
+		stdin, err := os.Create(filepath.Join(job.path, stdinFile))
+		if err != nil {
+			return job, err
+		}
+
+		defer stdin.Close()
+
+		if _, err := io.Copy(stdin, input); err != nil {
+			return job, err
+		}
+	}
+
+	return job, save(job)
+}
+
+// Start starts the supervisor of giving job, which is the `self` command
+// with the job's ID appended, detached from the current process.
+func Start(job Job, self ...string) error {
+	cmd := exec.Command(self[0], append(self[1:], job.ID)...)
+	cmd.Dir = job.Dir
+	detach(cmd)
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	return cmd.Process.Release()
+}
+
+// Supervise runs the command of the job with giving id, saving its output
+// and exit status into the job's directory.
+func Supervise(root string, id string) error {
+	job, err := Load(root, id)
+	if err != nil {
+		return err
+	}
+
+	// The supervisor is saved before anything else, so jobs only wait for it till it starts.
+	job.Supervisor = os.Getpid()
+	if err := save(job); err != nil {
+		return err
+	}
+
+	stdout, err := os.Create(job.Stdout())
+	if err != nil {
+		return err
+	}
+
+	defer stdout.Close()
+
+	stderr, err := os.Create(job.Stderr())
+	if err != nil {
+		return err
+	}
+
+	defer stderr.Close()
+
+	cmd := exec.Command(job.Command[0], job.Command[1:]...)
+	cmd.Dir = job.Dir
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if stdin, err := os.Open(filepath.Join(job.path, stdinFile)); err == nil {
+		defer stdin.Close()
+		cmd.Stdin = stdin
+	}
+
+	if err := cmd.Start(); err != nil {
+		return finish(job, Failed, -1, err)
+	}
+
+	job.PID = cmd.Process.Pid
+	if err := save(job); err != nil {
+		cmd.Process.Kill()
+		return err
+	}
+
+	waitErr := cmd.Wait()
+
+	status, code := Succeeded, cmd.ProcessState.ExitCode()
+	if waitErr != nil {
+		status = Failed
+	}
+
+	if _, err := os.Stat(filepath.Join(job.path, killFile)); err == nil {
+		status = Killed
+	}
+
+	return finish(job, status, code, waitErr)
+}
+
+// Load returns the job with giving id, marking running jobs whose supervisor
+// no longer exists, or never started, as lost.
+func Load(root string, id string) (Job, error) {
+	path := filepath.Join(root, id)
+
+	job, err := read(path)
+	if err != nil {
+		return job, err
+	}
+
+	if job.Status == Running && job.Supervisor == 0 && time.Since(job.Started) > startTimeout {
+		job.Status = Lost
+		job.Error = "supervisor of job never started"
+		return job, nil
+	}
+
+	if job.Status == Running && job.Supervisor != 0 && !alive(job.Supervisor) {
+		// The supervisor may have saved its last state just before exiting.
+		if job, err = read(path); err != nil {
+			return job, err
+		}
+
+		if job.Status == Running {
+			job.Status = Lost
+		}
+	}
+
+	return job, nil
+}
+
+// read returns the job saved within giving directory.
+func read(path string) (Job, error) {
+	job := Job{path: path}
+
+	data, err := os.ReadFile(filepath.Join(path, jobFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return job, ErrNotFound
+		}
+
+		return job, err
+	}
+
+	if err := json.Unmarshal(data, &job); err != nil {
+		return job, err
+	}
+
+	job.path = path
+	return job, nil
+}
+
+// List returns all jobs within root, ordered by their start time.
+func List(root string) ([]Job, error) {
+	dirs, err := os.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var list []Job
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+
+		job, err := Load(root, dir.Name())
+		if err != nil {
+			continue
+		}
+
+		list = append(list, job)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Started.Before(list[j].Started)
+	})
+
+	return list, nil
+}
+
+// Kill cancels giving running job by sending it SIGTERM.
+func Kill(job Job) error {
+	if job.Done() {
+		return ErrNotRunning
+	}
+
+	if job.PID == 0 {
+		return fmt.Errorf("job %q has not started its command yet", job.ID)
+	}
+
+	if err := os.WriteFile(filepath.Join(job.path, killFile), nil, 0600); err != nil {
+		return err
+	}
+
+	return terminate(job.PID)
+}
+
+// Wait waits till the job with giving id is done, checking on it at every interval.
+func Wait(ctx context.Context, root string, id string, interval time.Duration) (Job, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job, err := Load(root, id)
+		if err != nil || job.Done() {
+			return job, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Logs copies the output of the job with giving id into stdout and stderr. If
+// follow is true, it keeps copying new output till the job is done.
+func Logs(ctx context.Context, root string, id string, stdout, stderr io.Writer, follow bool) error {
+	job, err := Load(root, id)
+	if err != nil {
+		return err
+	}
+
+	var outOffset, errOffset int64
+	for {
+		// Load the job before copying, so output written before it ended is not missed.
+		if follow {
+			if job, err = Load(root, id); err != nil {
+				return err
+			}
+		}
+
+		if outOffset, err = copyFrom(job.Stdout(), outOffset, stdout); err != nil {
+			return err
+		}
+
+		if errOffset, err = copyFrom(job.Stderr(), errOffset, stderr); err != nil {
+			return err
+		}
+
+		if !follow || job.Done() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
+
+// copyFrom copies the content of giving file from offset into w, returning the new offset.
+func copyFrom(path string, offset int64, w io.Writer) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return offset, nil
+		}
+
+		return offset, err
+	}
+
+	defer file.Close()
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return offset, err
+	}
+
+	written, err := io.Copy(w, file)
+	return offset + written, err
+}
+
+// finish saves the final status of giving job.
+func finish(job Job, status Status, code int, err error) error {
+	job.Status = status
+	job.ExitCode = code
+	job.Ended = time.Now()
+
+	if err != nil && status != Killed {
+		job.Error = err.Error()
+	}
+
+	return save(job)
+}
+
+// save writes the job into its directory, replacing the previous file at once
+// so readers never see partial content.
+func save(job Job) error {
+	data, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	temp := filepath.Join(job.path, jobFile+".tmp")
+	if err := os.WriteFile(temp, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(temp, filepath.Join(job.path, jobFile))
+}
+
+// newID returns a new random job ID.
+func newID() (string, error) {
+	id := make([]byte, 4)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
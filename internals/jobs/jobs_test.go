@@ -0,0 +1,158 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+// shell returns the command running giving script with sh, skipping the test without one.
+func shell(t *testing.T, script string) []string {
+	t.Helper()
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	return []string{sh, "-c", script}
+}
+
+func TestSupervise(t *testing.T) {
+	root := t.TempDir()
+
+	job, err := Create(root, shell(t, "cat; echo oops >&2; exit 3"), t.TempDir(), strings.NewReader("hello\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded, err := Load(root, job.ID); err != nil || loaded.Status != Running {
+		t.Fatalf("created job is %s, %v, expected it running until its supervisor starts", loaded.Status, err)
+	}
+
+	if err := Supervise(root, job.ID); err != nil {
+		t.Fatalf("Supervise failed: %s", err)
+	}
+
+	job, err = Load(root, job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if job.Status != Failed || job.ExitCode != 3 || job.Supervisor != os.Getpid() || job.PID == 0 || job.Ended.IsZero() {
+		t.Errorf("supervised job ended as %+v", job)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := Logs(context.Background(), root, job.ID, &stdout, &stderr, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if stdout.String() != "hello\n" || stderr.String() != "oops\n" {
+		t.Errorf("logs are %q and %q", stdout.String(), stderr.String())
+	}
+
+	if err := Kill(job); err != ErrNotRunning {
+		t.Errorf("Kill of an ended job returned %v", err)
+	}
+}
+
+func TestKillAndWait(t *testing.T) {
+	root := t.TempDir()
+
+	job, err := Create(root, shell(t, "echo started; exec sleep 30"), t.TempDir(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	supervised := make(chan error)
+	go func() { supervised <- Supervise(root, job.ID) }()
+
+	// Output is followed while the job runs, and ends along with it.
+	var stdout bytes.Buffer
+	followed := make(chan error)
+	go func() { followed <- Logs(context.Background(), root, job.ID, &stdout, &bytes.Buffer{}, true) }()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for job.PID == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		job, _ = Load(root, job.ID)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := Wait(ctx, root, job.ID, 10*time.Millisecond); err != context.DeadlineExceeded {
+		t.Fatalf("Wait of a running job returned %v", err)
+	}
+
+	if err := Kill(job); err != nil {
+		t.Fatalf("Kill failed: %s", err)
+	}
+
+	if err := <-supervised; err != nil {
+		t.Errorf("Supervise failed: %s", err)
+	}
+
+	job, err = Wait(context.Background(), root, job.ID, 10*time.Millisecond)
+	if err != nil || job.Status != Killed || job.Error != "" {
+		t.Errorf("killed job ended as %+v, %v", job, err)
+	}
+
+	if err := <-followed; err != nil || stdout.String() != "started\n" {
+		t.Errorf("followed logs %q, %v", stdout.String(), err)
+	}
+}
+
+func TestLostJobs(t *testing.T) {
+	root := t.TempDir()
+
+	// A process which ended stands in for a supervisor which crashed.
+	gone := exec.Command(shell(t, "exit 0")[0], "-c", "exit 0")
+	if err := gone.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	crashed, _ := Create(root, []string{"crashed"}, "", nil)
+	crashed.Supervisor = gone.Process.Pid
+	save(crashed)
+
+	unstarted, _ := Create(root, []string{"unstarted"}, "", nil)
+	unstarted.Started = time.Now().Add(-2 * startTimeout)
+	save(unstarted)
+
+	starting, _ := Create(root, []string{"starting"}, "", nil)
+
+	list, err := List(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := map[string]Status{}
+	for _, job := range list {
+		statuses[job.Command[0]] = job.Status
+	}
+
+	expected := map[string]Status{"crashed": Lost, "unstarted": Lost, "starting": Running}
+	for name, status := range expected {
+		if statuses[name] != status {
+			t.Errorf("%s job is %s, expected %s", name, statuses[name], status)
+		}
+	}
+
+	if list[0].Command[0] != "unstarted" {
+		t.Errorf("jobs are not listed by their start, first is %q", list[0].Command[0])
+	}
+
+	if _, err := Load(root, "missing"); err != ErrNotFound {
+		t.Errorf("Load of a missing job returned %v", err)
+	}
+
+	if err := Kill(starting); err == nil {
+		t.Errorf("Kill of a job without a command succeeded")
+	}
+}
@@ -0,0 +1,26 @@
+//go:build !windows
+// +build !windows
+
+package jobs
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detach starts giving command in its own session, so it outlives the current
+// process and the terminal it runs in.
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+}
+
+// alive returns true/false if a process with giving pid exists.
+func alive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
+
+// terminate sends SIGTERM to the process with giving pid.
+func terminate(pid int) error {
+	return syscall.Kill(pid, syscall.SIGTERM)
+}
@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// detach starts giving command in a new process group, so it is not stopped
+// along with the current console.
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// alive returns true/false if a process with giving pid exists.
+func alive(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	process.Release()
+	return true
+}
+
+// terminate stops the process with giving pid, as windows has no SIGTERM.
+func terminate(pid int) error {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+
+	return process.Kill()
+}
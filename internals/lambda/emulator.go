@@ -0,0 +1,245 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// consts of the emulator.
+const (
+	// InvokePath is where the emulator takes invocations, like the Runtime Interface Emulator of AWS.
+	InvokePath = "/2015-03-31/functions/function/invocations"
+
+	// DefaultARN is the ARN of the function given to runtimes by the emulator.
+	DefaultARN = "arn:aws:lambda:local:000000000000:function:shogun"
+)
+
+// errors.
+var (
+	// ErrInvokeTimeout is returned by Invoke when the runtime did not respond within the deadline.
+	ErrInvokeTimeout = errors.New("Task timed out before the runtime responded")
+)
+
+// Result is the result of an invocation, which failed if Error is set.
+type Result struct {
+	RequestID string
+	Output    []byte
+	Error     []byte
+	ErrorType string
+}
+
+type pending struct {
+	inv  Invocation
+	done chan Result
+}
+
+// Emulator emulates the Runtime API, handing invocations to a runtime polling it.
+type Emulator struct {
+	// Timeout sets the deadline of invocations.
+	Timeout time.Duration
+
+	queue chan *pending
+
+	mu        sync.Mutex
+	running   map[string]*pending
+	initError []byte
+}
+
+// NewEmulator returns a new Emulator whose invocations have giving timeout.
+func NewEmulator(timeout time.Duration) *Emulator {
+	return &Emulator{
+		Timeout: timeout,
+		queue:   make(chan *pending),
+		running: make(map[string]*pending),
+	}
+}
+
+// Handler returns the http.Handler serving the Runtime API and InvokePath.
+func (e *Emulator) Handler() http.Handler {
+	runtime := "/" + APIVersion + "/runtime/"
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.URL.Path
+
+		switch {
+		case route == runtime+"invocation/next" && r.Method == http.MethodGet:
+			e.next(w, r)
+		case route == runtime+"init/error" && r.Method == http.MethodPost:
+			e.initFailed(w, r)
+		case route == InvokePath && r.Method == http.MethodPost:
+			e.invoke(w, r)
+		case strings.HasPrefix(route, runtime+"invocation/") && r.Method == http.MethodPost:
+			id, kind := path.Split(strings.TrimPrefix(route, runtime+"invocation/"))
+			if kind != "response" && kind != "error" {
+				http.NotFound(w, r)
+				return
+			}
+
+			e.respond(w, r, strings.TrimSuffix(id, "/"), kind == "error")
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// Invoke hands giving event to the runtime, returning its result once it responds.
+// It fails if the runtime reported an init error, or did not respond before the
+// invocation's deadline or the end of ctx.
+func (e *Emulator) Invoke(ctx context.Context, event []byte) (Result, error) {
+	if err := e.initErr(); err != nil {
+		return Result{}, err
+	}
+
+	p := &pending{
+		done: make(chan Result, 1),
+		inv: Invocation{
+			RequestID:   internals.NewInvocationID(),
+			Deadline:    time.Now().Add(e.Timeout),
+			FunctionARN: DefaultARN,
+			TraceID:     "Root=1-" + strconv.FormatInt(time.Now().Unix(), 16) + "-" + internals.NewInvocationID(),
+			Event:       event,
+		},
+	}
+
+	// The deadline is given a moment more, so runtimes can post errors of timed out invocations.
+	ctx, cancel := context.WithDeadline(ctx, p.inv.Deadline.Add(time.Second))
+	defer cancel()
+
+	select {
+	case e.queue <- p:
+	case <-ctx.Done():
+		return Result{}, e.failure(ctx)
+	}
+
+	select {
+	case res := <-p.done:
+		return res, nil
+	case <-ctx.Done():
+		e.mu.Lock()
+		delete(e.running, p.inv.RequestID)
+		e.mu.Unlock()
+
+		return Result{}, e.failure(ctx)
+	}
+}
+
+func (e *Emulator) failure(ctx context.Context) error {
+	if err := e.initErr(); err != nil {
+		return err
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ErrInvokeTimeout
+	}
+
+	return ctx.Err()
+}
+
+func (e *Emulator) initErr() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.initError == nil {
+		return nil
+	}
+
+	return fmt.Errorf("Runtime failed to start: %s", e.initError)
+}
+
+func (e *Emulator) next(w http.ResponseWriter, r *http.Request) {
+	var p *pending
+
+	select {
+	case p = <-e.queue:
+	case <-r.Context().Done():
+		return
+	}
+
+	e.mu.Lock()
+	e.running[p.inv.RequestID] = p
+	e.mu.Unlock()
+
+	w.Header().Set(HeaderRequestID, p.inv.RequestID)
+	w.Header().Set(HeaderDeadline, strconv.FormatInt(p.inv.Deadline.UnixMilli(), 10))
+	w.Header().Set(HeaderFunctionARN, p.inv.FunctionARN)
+	w.Header().Set(HeaderTraceID, p.inv.TraceID)
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(p.inv.Event)
+}
+
+// respond completes an invocation with its response or error.
+func (e *Emulator) respond(w http.ResponseWriter, r *http.Request, id string, failed bool) {
+	e.mu.Lock()
+	p, ok := e.running[id]
+	delete(e.running, id)
+	e.mu.Unlock()
+
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown request id %q", id), http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res := Result{RequestID: id, Output: body}
+	if failed {
+		res = Result{RequestID: id, Error: body, ErrorType: r.Header.Get(HeaderErrorType)}
+	}
+
+	p.done <- res
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (e *Emulator) initFailed(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	e.mu.Lock()
+	e.initError = body
+	e.mu.Unlock()
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// invoke serves InvokePath, responding with the output of the invocation, or its
+// error with the X-Amz-Function-Error header set as Lambda does.
+func (e *Emulator) invoke(w http.ResponseWriter, r *http.Request) {
+	event, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := e.Invoke(r.Context(), event)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadGateway)
+		json.NewEncoder(w).Encode(ErrorResponse{ErrorMessage: err.Error(), ErrorType: "Runtime.Unavailable"})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(HeaderRequestID, res.RequestID)
+
+	if res.Error != nil {
+		w.Header().Set(HeaderFunctionError, "Unhandled")
+		w.Write(res.Error)
+		return
+	}
+
+	w.Write(res.Output)
+}
@@ -0,0 +1,221 @@
+// Package lambda implements the client loop of the AWS Lambda Runtime API, with
+// which generated binaries run as custom runtimes, and an emulator of the API for
+// running them locally.
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// consts of the Runtime API.
+const (
+	APIEnv     = "AWS_LAMBDA_RUNTIME_API"
+	APIVersion = "2018-06-01"
+
+	HeaderRequestID     = "Lambda-Runtime-Aws-Request-Id"
+	HeaderDeadline      = "Lambda-Runtime-Deadline-Ms"
+	HeaderFunctionARN   = "Lambda-Runtime-Invoked-Function-Arn"
+	HeaderTraceID       = "Lambda-Runtime-Trace-Id"
+	HeaderErrorType     = "Lambda-Runtime-Function-Error-Type"
+	HeaderFunctionError = "X-Amz-Function-Error"
+
+	traceEnv = "_X_AMZN_TRACE_ID"
+)
+
+// errors.
+var (
+	// ErrNoAPI is returned when the address of the Runtime API is not set.
+	ErrNoAPI = errors.New(APIEnv + " is not set, run within AWS Lambda or `shogun lambda`")
+)
+
+// Invocation contains an event to be handled by a function, with its details.
+type Invocation struct {
+	RequestID   string
+	Deadline    time.Time
+	FunctionARN string
+	TraceID     string
+	Event       []byte
+}
+
+// ErrorResponse is posted for failed invocations, with the function's error envelope.
+type ErrorResponse struct {
+	ErrorMessage string                   `json:"errorMessage"`
+	ErrorType    string                   `json:"errorType"`
+	StackTrace   []string                 `json:"stackTrace,omitempty"`
+	Envelope     *internals.ErrorEnvelope `json:"envelope,omitempty"`
+}
+
+// NewErrorResponse returns the ErrorResponse for giving error of function.
+func NewErrorResponse(function string, err error) ErrorResponse {
+	envelope := internals.NewErrorEnvelope(function, err)
+	return ErrorResponse{
+		ErrorMessage: envelope.Message,
+		ErrorType:    envelope.Code,
+		Envelope:     &envelope,
+	}
+}
+
+// Handler handles an invocation, reading its event from input and writing its response into output.
+type Handler func(ctx context.Context, inv Invocation, input io.Reader, output io.Writer) error
+
+// Client calls the Runtime API at an address.
+type Client struct {
+	API  string
+	HTTP *http.Client
+}
+
+// NewClient returns a new Client for the Runtime API at giving address, like `127.0.0.1:9001`.
+func NewClient(api string) *Client {
+	return &Client{API: api, HTTP: &http.Client{}}
+}
+
+func (c *Client) url(path string) string {
+	return "http://" + c.API + "/" + APIVersion + path
+}
+
+// Next waits for the next invocation.
+func (c *Client) Next(ctx context.Context) (Invocation, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url("/runtime/invocation/next"), nil)
+	if err != nil {
+		return Invocation{}, err
+	}
+
+	res, err := c.HTTP.Do(req)
+	if err != nil {
+		return Invocation{}, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return Invocation{}, fmt.Errorf("Runtime API returned %s for next invocation", res.Status)
+	}
+
+	event, err := io.ReadAll(res.Body)
+	if err != nil {
+		return Invocation{}, err
+	}
+
+	inv := Invocation{
+		RequestID:   res.Header.Get(HeaderRequestID),
+		FunctionARN: res.Header.Get(HeaderFunctionARN),
+		TraceID:     res.Header.Get(HeaderTraceID),
+		Event:       event,
+	}
+
+	if ms, err := strconv.ParseInt(res.Header.Get(HeaderDeadline), 10, 64); err == nil {
+		inv.Deadline = time.UnixMilli(ms)
+	}
+
+	return inv, nil
+}
+
+// Respond posts the response of giving invocation.
+func (c *Client) Respond(ctx context.Context, requestID string, output []byte) error {
+	return c.post(ctx, "/runtime/invocation/"+requestID+"/response", output, "")
+}
+
+// Fail posts the error of giving invocation.
+func (c *Client) Fail(ctx context.Context, requestID string, res ErrorResponse) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	return c.post(ctx, "/runtime/invocation/"+requestID+"/error", data, res.ErrorType)
+}
+
+// InitError posts an error which keeps the runtime from handling invocations.
+func (c *Client) InitError(ctx context.Context, res ErrorResponse) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	return c.post(ctx, "/runtime/init/error", data, res.ErrorType)
+}
+
+func (c *Client) post(ctx context.Context, path string, body []byte, errorType string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url(path), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	if errorType != "" {
+		req.Header.Set(HeaderErrorType, errorType)
+	}
+
+	res, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode != http.StatusAccepted && res.StatusCode != http.StatusOK {
+		return fmt.Errorf("Runtime API returned %s for %s", res.Status, path)
+	}
+
+	return nil
+}
+
+// Start handles the invocations of the Runtime API at api with handler, one at a time,
+// until ctx is cancelled, where errors are reported as those of giving function. Each
+// invocation's context ends at its deadline.
+func Start(ctx context.Context, api string, function string, handler Handler) error {
+	client := NewClient(api)
+
+	for {
+		inv, err := client.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		if err := invoke(ctx, client, function, inv, handler); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
+
+// invoke handles a single invocation, posting its output or error.
+func invoke(ctx context.Context, client *Client, function string, inv Invocation, handler Handler) error {
+	// The trace ID is read from the environment by the X-Ray SDK.
+	os.Setenv(traceEnv, inv.TraceID)
+
+	callCtx := ctx
+	if !inv.Deadline.IsZero() {
+		var cancel context.CancelFunc
+		callCtx, cancel = context.WithDeadline(ctx, inv.Deadline)
+		defer cancel()
+	}
+
+	var output bytes.Buffer
+	err := handler(callCtx, inv, bytes.NewReader(inv.Event), &output)
+
+	// Responses are posted even when the invocation's deadline passed, so use ctx.
+	if err != nil {
+		return client.Fail(ctx, inv.RequestID, NewErrorResponse(function, err))
+	}
+
+	return client.Respond(ctx, inv.RequestID, output.Bytes())
+}
@@ -0,0 +1,160 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// greet is the handler of the runtime under test, which behaves as its event asks.
+func greet(ctx context.Context, inv Invocation, input io.Reader, output io.Writer) error {
+	var event struct {
+		Name string `json:"name"`
+	}
+
+	if err := json.NewDecoder(input).Decode(&event); err != nil {
+		return internals.InvalidInput(err)
+	}
+
+	switch event.Name {
+	case "":
+		return errors.New("nobody to greet")
+	case "sleepy":
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	if os.Getenv(traceEnv) != inv.TraceID || inv.FunctionARN != DefaultARN {
+		return fmt.Errorf("invocation has trace %q and arn %q", inv.TraceID, inv.FunctionARN)
+	}
+
+	_, err := fmt.Fprintf(output, `{"greeting":"hello %s"}`, event.Name)
+	return err
+}
+
+// TestRuntime runs the runtime loop of generated binaries against the emulator
+// of `shogun lambda`, invoking it over HTTP as clients of the emulator do.
+func TestRuntime(t *testing.T) {
+	t.Setenv(traceEnv, "")
+
+	emulator := NewEmulator(300 * time.Millisecond)
+	ts := httptest.NewServer(emulator.Handler())
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error)
+	go func() { stopped <- Start(ctx, strings.TrimPrefix(ts.URL, "http://"), "Greet", greet) }()
+
+	invoke := func(event string) (*http.Response, string) {
+		res, err := http.Post(ts.URL+InvokePath, "application/json", strings.NewReader(event))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		defer res.Body.Close()
+
+		body, _ := io.ReadAll(res.Body)
+		return res, string(body)
+	}
+
+	res, body := invoke(`{"name":"bat"}`)
+	if res.StatusCode != http.StatusOK || body != `{"greeting":"hello bat"}` || res.Header.Get(HeaderFunctionError) != "" {
+		t.Errorf("invocation returned %s %q", res.Status, body)
+	}
+
+	if res.Header.Get(HeaderRequestID) == "" {
+		t.Error("invocation has no request id")
+	}
+
+	tests := []struct {
+		event    string
+		envelope internals.ErrorEnvelope
+	}{
+		{event: `{}`, envelope: internals.ErrorEnvelope{Code: internals.CodeError, Message: "nobody to greet", ExitCode: internals.ExitError}},
+		{event: `{"name":`, envelope: internals.ErrorEnvelope{Code: internals.CodeInvalidInput, Message: `Expected Valid JSON: "unexpected EOF"`, ExitCode: internals.ExitInvalidInput}},
+		{event: `{"name":"sleepy"}`, envelope: internals.ErrorEnvelope{Code: internals.CodeTimeout, Message: "context deadline exceeded", ExitCode: internals.ExitTimeout, Retryable: true}},
+	}
+
+	for _, test := range tests {
+		res, body := invoke(test.event)
+		if res.StatusCode != http.StatusOK || res.Header.Get(HeaderFunctionError) != "Unhandled" {
+			t.Errorf("failed invocation of %s returned %s with function error %q", test.event, res.Status, res.Header.Get(HeaderFunctionError))
+		}
+
+		var failure ErrorResponse
+		if err := json.Unmarshal([]byte(body), &failure); err != nil || failure.Envelope == nil {
+			t.Fatalf("invocation of %s returned %q: %v", test.event, body, err)
+		}
+
+		test.envelope.Function = "Greet"
+		if *failure.Envelope != test.envelope || failure.ErrorType != test.envelope.Code || failure.ErrorMessage != test.envelope.Message {
+			t.Errorf("invocation of %s failed with %+v, expected %+v", test.event, failure, test.envelope)
+		}
+	}
+
+	cancel()
+	if err := <-stopped; err != nil {
+		t.Errorf("runtime stopped with %v", err)
+	}
+}
+
+func TestEmulatorFailures(t *testing.T) {
+	t.Run("runtime failed to start", func(t *testing.T) {
+		emulator := NewEmulator(time.Second)
+		ts := httptest.NewServer(emulator.Handler())
+		defer ts.Close()
+
+		client := NewClient(strings.TrimPrefix(ts.URL, "http://"))
+		if err := client.InitError(context.Background(), NewErrorResponse("Greet", errors.New("missing config"))); err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := http.Post(ts.URL+InvokePath, "application/json", strings.NewReader(`{}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		defer res.Body.Close()
+
+		var failure ErrorResponse
+		json.NewDecoder(res.Body).Decode(&failure)
+
+		if res.StatusCode != http.StatusBadGateway || !strings.Contains(failure.ErrorMessage, "missing config") {
+			t.Errorf("invocation returned %s %+v", res.Status, failure)
+		}
+	})
+
+	t.Run("no runtime polling", func(t *testing.T) {
+		emulator := NewEmulator(50 * time.Millisecond)
+		if _, err := emulator.Invoke(context.Background(), []byte(`{}`)); err != ErrInvokeTimeout {
+			t.Errorf("Invoke returned %v, expected ErrInvokeTimeout", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if _, err := emulator.Invoke(ctx, []byte(`{}`)); err != context.Canceled {
+			t.Errorf("cancelled Invoke returned %v", err)
+		}
+	})
+
+	t.Run("unknown request", func(t *testing.T) {
+		ts := httptest.NewServer(NewEmulator(time.Second).Handler())
+		defer ts.Close()
+
+		client := NewClient(strings.TrimPrefix(ts.URL, "http://"))
+		if err := client.Respond(context.Background(), "unknown", []byte(`{}`)); err == nil {
+			t.Error("response to an unknown request was accepted")
+		}
+	})
+}
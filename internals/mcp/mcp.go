@@ -0,0 +1,310 @@
+// Package mcp serves the functions of a generated binary as tools over the stdio
+// transport of the Model Context Protocol, where each message is a line of JSON-RPC.
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/influx6/shogun/internals"
+	"github.com/influx6/shogun/internals/serve"
+)
+
+// LatestVersion is the latest version of the protocol served, used when clients ask for an unknown one.
+const LatestVersion = "2025-06-18"
+
+// Versions contains the versions of the protocol served.
+var Versions = []string{"2024-11-05", "2025-03-26", LatestVersion}
+
+// consts of JSON-RPC error codes.
+const (
+	codeParse          = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Content is a part of the result of a tool call.
+type Content struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Result is the result of a tool call, which is an error for the calling model if IsError is set.
+type Result struct {
+	Content []Content `json:"content"`
+	IsError bool      `json:"isError"`
+}
+
+// Server serves functions as tools, named after them like `greet` for those of the
+// binary and `sub_words` for those of its subcommands.
+type Server struct {
+	Binary string
+
+	// Version is reported as the version of the server, like `1.0.0`.
+	Version string
+
+	Functions []internals.ShogunFunc
+	Call      serve.Caller
+
+	mu      sync.Mutex
+	out     *json.Encoder
+	running map[string]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// Name returns the name of the tool of giving function.
+func (s *Server) Name(fn internals.ShogunFunc) string {
+	if fn.Binary == "" || fn.Binary == s.Binary {
+		return fn.NS
+	}
+
+	return fn.Binary + "_" + fn.NS
+}
+
+// Serve reads requests from r and writes their responses into w until r ends or ctx
+// is cancelled. Tool calls run concurrently, and are cancelled by the client's
+// `notifications/cancelled` or with ctx.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.out = json.NewEncoder(w)
+	s.running = make(map[string]context.CancelFunc)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		s.wg.Wait()
+	}()
+
+	// Lines are read apart from handling them, so a cancelled ctx ends Serve while waiting for one.
+	lines := make(chan []byte)
+	failed := make(chan error, 1)
+
+	go func() {
+		defer close(lines)
+
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+
+		for scanner.Scan() {
+			select {
+			case lines <- append([]byte(nil), scanner.Bytes()...):
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		failed <- scanner.Err()
+	}()
+
+	for {
+		var line []byte
+		var ok bool
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case line, ok = <-lines:
+		}
+
+		// Calls still running when input ends are answered before returning.
+		if !ok {
+			s.wg.Wait()
+			return <-failed
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		var req request
+		if err := json.Unmarshal(line, &req); err != nil {
+			s.fail(nil, codeParse, "Parse error: "+err.Error())
+			continue
+		}
+
+		if req.JSONRPC != "2.0" || req.Method == "" {
+			s.fail(req.ID, codeInvalidRequest, "Invalid request: expected a JSON-RPC 2.0 method call")
+			continue
+		}
+
+		s.handle(ctx, req)
+	}
+}
+
+func (s *Server) handle(ctx context.Context, req request) {
+	// Requests without an ID are notifications, which are never answered.
+	notification := len(req.ID) == 0
+
+	switch req.Method {
+	case "initialize":
+		var params struct {
+			ProtocolVersion string `json:"protocolVersion"`
+		}
+
+		json.Unmarshal(req.Params, &params)
+
+		version := LatestVersion
+		for _, supported := range Versions {
+			if supported == params.ProtocolVersion {
+				version = supported
+			}
+		}
+
+		s.reply(req.ID, map[string]interface{}{
+			"protocolVersion": version,
+			"capabilities":    map[string]interface{}{"tools": map[string]interface{}{}},
+			"serverInfo":      map[string]string{"name": s.Binary, "version": s.Version},
+		})
+	case "ping":
+		s.reply(req.ID, map[string]interface{}{})
+	case "tools/list":
+		tools := make([]Tool, 0, len(s.Functions))
+		for _, fn := range s.Functions {
+			tools = append(tools, ToolOf(s.Name(fn), fn))
+		}
+
+		s.reply(req.ID, map[string]interface{}{"tools": tools})
+	case "tools/call":
+		var params struct {
+			Name      string          `json:"name"`
+			Arguments json.RawMessage `json:"arguments"`
+		}
+
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			s.fail(req.ID, codeInvalidParams, "Invalid params: "+err.Error())
+			return
+		}
+
+		fn, ok := s.find(params.Name)
+		if !ok {
+			s.fail(req.ID, codeInvalidParams, fmt.Sprintf("Unknown tool %q", params.Name))
+			return
+		}
+
+		callCtx, cancel := context.WithCancel(ctx)
+
+		s.mu.Lock()
+		s.running[string(req.ID)] = cancel
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer func() {
+				s.mu.Lock()
+				delete(s.running, string(req.ID))
+				s.mu.Unlock()
+				cancel()
+			}()
+
+			s.reply(req.ID, s.call(callCtx, fn, params.Arguments))
+		}()
+	case "notifications/cancelled":
+		var params struct {
+			RequestID json.RawMessage `json:"requestId"`
+		}
+
+		json.Unmarshal(req.Params, &params)
+
+		s.mu.Lock()
+		if cancel, ok := s.running[string(params.RequestID)]; ok {
+			cancel()
+		}
+		s.mu.Unlock()
+	default:
+		if !notification {
+			s.fail(req.ID, codeMethodNotFound, fmt.Sprintf("Method %q not found", req.Method))
+		}
+	}
+}
+
+// call calls giving function with the arguments of a tool call, returning its
+// output, or its error envelope after any output if it failed.
+func (s *Server) call(ctx context.Context, fn internals.ShogunFunc, arguments json.RawMessage) Result {
+	args, flags, input, err := Arguments(fn, arguments)
+	if err != nil {
+		return errorResult(fn, internals.InvalidInput(err), "")
+	}
+
+	var output bytes.Buffer
+	if err := s.Call(ctx, fn, args, flags, input, nopCloser{Writer: &output}); err != nil {
+		return errorResult(fn, err, output.String())
+	}
+
+	return Result{Content: []Content{{Type: "text", Text: output.String()}}}
+}
+
+func errorResult(fn internals.ShogunFunc, err error, output string) Result {
+	envelope, _ := json.Marshal(internals.NewErrorEnvelope(fn.Name, err))
+
+	var content []Content
+	if output != "" {
+		content = append(content, Content{Type: "text", Text: output})
+	}
+
+	return Result{Content: append(content, Content{Type: "text", Text: string(envelope)}), IsError: true}
+}
+
+// find returns the function of the tool with giving name.
+func (s *Server) find(name string) (internals.ShogunFunc, bool) {
+	for _, fn := range s.Functions {
+		if s.Name(fn) == name {
+			return fn, true
+		}
+	}
+
+	return internals.ShogunFunc{}, false
+}
+
+func (s *Server) reply(id json.RawMessage, result interface{}) {
+	s.write(response{JSONRPC: "2.0", ID: id, Result: result})
+}
+
+func (s *Server) fail(id json.RawMessage, code int, message string) {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+
+	s.write(response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: message}})
+}
+
+func (s *Server) write(res response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.out.Encode(res)
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+// Close does nothing.
+func (nopCloser) Close() error {
+	return nil
+}
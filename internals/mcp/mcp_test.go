@@ -0,0 +1,159 @@
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// transcript holds the messages of a client, one per line, with notifications
+// and malformed requests among them.
+const transcript = `
+{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05"}}
+{"jsonrpc":"2.0","method":"notifications/initialized"}
+{"jsonrpc":"2.0","id":2,"method":"initialize","params":{"protocolVersion":"1999-01-01"}}
+{"jsonrpc":"2.0","id":3,"method":"ping"}
+{"jsonrpc":"2.0","id":4,"method":"tools/list"}
+{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"greet","arguments":{"input":"bat","flags":{"loud":true,"times":2}}}}
+{"jsonrpc":"2.0","id":6,"method":"tools/call","params":{"name":"sub_words","arguments":{"args":["a","b"]}}}
+{"jsonrpc":"2.0","id":7,"method":"tools/call","params":{"name":"greet","arguments":{"input":"nobody"}}}
+{"jsonrpc":"2.0","id":8,"method":"tools/call","params":{"name":"greet","arguments":{"input":42}}}
+{"jsonrpc":"2.0","id":9,"method":"tools/call","params":{"name":"missing"}}
+{"jsonrpc":"2.0","id":10,"method":"resources/list"}
+{"jsonrpc":"1.0","id":11,"method":"ping"}
+not json
+`
+
+var tools = []internals.ShogunFunc{
+	{
+		NS:     "greet",
+		Name:   "Greet",
+		Binary: "demo",
+		Type:   internals.WithStringArgumentAndWriteCloserArgument,
+		Flags:  internals.Flags{{Name: "loud", Type: internals.BoolFlag}, {Name: "times", Type: internals.IntFlag}},
+	},
+	{NS: "words", Name: "Words", Binary: "sub", Type: internals.WithStringSliceArgument},
+}
+
+func greetOrWords(ctx context.Context, fn internals.ShogunFunc, args []string, flags []string, input io.Reader, output io.WriteCloser) error {
+	data, _ := io.ReadAll(input)
+	if string(data) == "nobody" {
+		io.WriteString(output, "looking...")
+		return errors.New("nobody to greet")
+	}
+
+	fmt.Fprintf(output, "%s %s %q %q", fn.Name, data, args, flags)
+	return nil
+}
+
+func TestServe(t *testing.T) {
+	server := &Server{Binary: "demo", Version: "1.2.3", Functions: tools, Call: greetOrWords}
+
+	var out strings.Builder
+	if err := server.Serve(context.Background(), strings.NewReader(transcript), &out); err != nil {
+		t.Fatalf("Serve failed: %s", err)
+	}
+
+	responses := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var res struct {
+			ID json.RawMessage `json:"id"`
+		}
+
+		if err := json.Unmarshal([]byte(line), &res); err != nil {
+			t.Fatalf("invalid response %q: %s", line, err)
+		}
+
+		responses[string(res.ID)] += line
+	}
+
+	expected := map[string]string{
+		"1":    `{"jsonrpc":"2.0","id":1,"result":{"capabilities":{"tools":{}},"protocolVersion":"2024-11-05","serverInfo":{"name":"demo","version":"1.2.3"}}}`,
+		"2":    `{"jsonrpc":"2.0","id":2,"result":{"capabilities":{"tools":{}},"protocolVersion":"` + LatestVersion + `","serverInfo":{"name":"demo","version":"1.2.3"}}}`,
+		"3":    `{"jsonrpc":"2.0","id":3,"result":{}}`,
+		"5":    `{"jsonrpc":"2.0","id":5,"result":{"content":[{"type":"text","text":"Greet bat [] [\"loud=true\" \"times=2\"]"}],"isError":false}}`,
+		"6":    `{"jsonrpc":"2.0","id":6,"result":{"content":[{"type":"text","text":"Words  [\"a\" \"b\"] []"}],"isError":false}}`,
+		"7":    `{"jsonrpc":"2.0","id":7,"result":{"content":[{"type":"text","text":"looking..."},{"type":"text","text":"{\"code\":\"error\",\"message\":\"nobody to greet\",\"function\":\"Greet\",\"retryable\":false,\"exit_code\":1}"}],"isError":true}}`,
+		"8":    `{"jsonrpc":"2.0","id":8,"result":{"content":[{"type":"text","text":"{\"code\":\"invalid_input\",\"message\":\"Expected Valid JSON: \\\"input must be a string\\\"\",\"function\":\"Greet\",\"retryable\":false,\"exit_code\":2}"}],"isError":true}}`,
+		"9":    `{"jsonrpc":"2.0","id":9,"error":{"code":-32602,"message":"Unknown tool \"missing\""}}`,
+		"10":   `{"jsonrpc":"2.0","id":10,"error":{"code":-32601,"message":"Method \"resources/list\" not found"}}`,
+		"11":   `{"jsonrpc":"2.0","id":11,"error":{"code":-32600,"message":"Invalid request: expected a JSON-RPC 2.0 method call"}}`,
+		"null": `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"Parse error: invalid character 'o' in literal null (expecting 'u')"}}`,
+	}
+
+	for id, response := range expected {
+		if responses[id] != response {
+			t.Errorf("response to %s:\n%s\nexpected:\n%s", id, responses[id], response)
+		}
+	}
+
+	var list struct {
+		Result struct {
+			Tools []Tool `json:"tools"`
+		} `json:"result"`
+	}
+
+	if err := json.Unmarshal([]byte(responses["4"]), &list); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list.Result.Tools) != 2 || list.Result.Tools[0].Name != "greet" || list.Result.Tools[1].Name != "sub_words" {
+		t.Errorf("tools/list returned %s", responses["4"])
+	}
+
+	if len(responses) != len(expected)+1 {
+		t.Errorf("%d responses were written, expected one for each request but the notifications", len(responses))
+	}
+}
+
+func TestServeCancelledCall(t *testing.T) {
+	started := make(chan struct{})
+
+	server := &Server{
+		Binary:    "demo",
+		Functions: tools,
+		Call: func(ctx context.Context, fn internals.ShogunFunc, args []string, flags []string, input io.Reader, output io.WriteCloser) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+
+	in, client := io.Pipe()
+	out, responses := io.Pipe()
+
+	done := make(chan error)
+	go func() { done <- server.Serve(context.Background(), in, responses) }()
+
+	io.WriteString(client, `{"jsonrpc":"2.0","id":"slow","method":"tools/call","params":{"name":"greet","arguments":{"input":"bat"}}}`+"\n")
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("call did not start")
+	}
+
+	io.WriteString(client, `{"jsonrpc":"2.0","method":"notifications/cancelled","params":{"requestId":"slow"}}`+"\n")
+
+	line, err := bufio.NewReader(out).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(line, `"id":"slow"`) || !strings.Contains(line, `\"code\":\"cancelled\"`) {
+		t.Errorf("cancelled call was answered with %s", line)
+	}
+
+	client.Close()
+	if err := <-done; err != nil {
+		t.Errorf("Serve failed: %s", err)
+	}
+}
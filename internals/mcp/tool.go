@@ -0,0 +1,181 @@
+package mcp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/influx6/shogun/internals"
+	"github.com/influx6/shogun/internals/ui"
+)
+
+// Tool describes a function to clients, with a JSON Schema of the arguments of its calls.
+type Tool struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	InputSchema map[string]interface{} `json:"inputSchema"`
+}
+
+// ToolOf returns the Tool with giving name for a function, whose arguments are
+// `input` for what it reads, `args` for its string slice and `flags` for its flags.
+func ToolOf(name string, fn internals.ShogunFunc) Tool {
+	properties := map[string]interface{}{}
+	var required []string
+
+	input := ui.InputOf(fn)
+	switch input.Kind {
+	case ui.InputStruct:
+		fields := map[string]interface{}{}
+		for _, field := range input.Fields {
+			fields[field.Name] = fieldSchema(field)
+		}
+
+		properties["input"] = map[string]interface{}{
+			"type":        "object",
+			"description": fmt.Sprintf("Input decoded as JSON into %s", input.Type),
+			"properties":  fields,
+		}
+		required = append(required, "input")
+	case ui.InputJSON:
+		properties["input"] = map[string]interface{}{"description": fmt.Sprintf("Input decoded as JSON into %s", input.Type)}
+		required = append(required, "input")
+	case ui.InputText:
+		properties["input"] = map[string]interface{}{"type": "string", "description": "Input read as text"}
+	case ui.InputArgs:
+		properties["args"] = map[string]interface{}{
+			"type":        "array",
+			"items":       map[string]string{"type": "string"},
+			"description": "Arguments of the function",
+		}
+	}
+
+	if len(fn.Flags) != 0 {
+		flags := map[string]interface{}{}
+		for _, flag := range fn.Flags {
+			flags[flag.Name] = flagSchema(flag)
+		}
+
+		properties["flags"] = map[string]interface{}{"type": "object", "properties": flags}
+	}
+
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	if len(required) != 0 {
+		schema["required"] = required
+	}
+
+	description := fn.Doc()
+	if description == "" {
+		description = fn.Name
+	}
+
+	return Tool{Name: name, Description: description, InputSchema: schema}
+}
+
+func fieldSchema(field ui.Field) map[string]interface{} {
+	schema := map[string]interface{}{"description": field.Go}
+
+	switch field.Type {
+	case ui.FieldString:
+		schema["type"] = "string"
+	case ui.FieldNumber:
+		schema["type"] = "number"
+	case ui.FieldBoolean:
+		schema["type"] = "boolean"
+	}
+
+	return schema
+}
+
+func flagSchema(flag internals.Flag) map[string]interface{} {
+	schema := map[string]interface{}{"description": flag.Desc}
+
+	switch flag.Type {
+	case internals.BoolFlag, internals.TBoolFlag:
+		schema["type"] = "boolean"
+	case internals.IntFlag, internals.Int64Flag, internals.UintFlag, internals.Uint64Flag:
+		schema["type"] = "integer"
+	case internals.Float64Flag:
+		schema["type"] = "number"
+	case internals.DurationFlag:
+		schema["type"] = "string"
+		schema["description"] = strings.TrimSpace(flag.Desc + " (duration like 30s or 1h)")
+	case internals.IntSliceFlag, internals.Int64SliceFlag:
+		schema["type"] = "array"
+		schema["items"] = map[string]string{"type": "integer"}
+	case internals.Float64SliceFlag:
+		schema["type"] = "array"
+		schema["items"] = map[string]string{"type": "number"}
+	case internals.BoolSliceFlag:
+		schema["type"] = "array"
+		schema["items"] = map[string]string{"type": "boolean"}
+	case internals.StringSliceFlag:
+		schema["type"] = "array"
+		schema["items"] = map[string]string{"type": "string"}
+	default:
+		schema["type"] = "string"
+	}
+
+	return schema
+}
+
+// Arguments returns the args, flags and input of a call of giving function from
+// the arguments of a tool call.
+func Arguments(fn internals.ShogunFunc, arguments json.RawMessage) ([]string, []string, io.Reader, error) {
+	var call struct {
+		Input json.RawMessage        `json:"input"`
+		Args  []string               `json:"args"`
+		Flags map[string]interface{} `json:"flags"`
+	}
+
+	if len(arguments) != 0 {
+		if err := json.Unmarshal(arguments, &call); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	var flags []string
+	for _, flag := range fn.Flags {
+		value, ok := call.Flags[flag.Name]
+		if !ok || value == nil {
+			continue
+		}
+
+		flags = append(flags, flag.Name+"="+format(value))
+	}
+
+	var input io.Reader = strings.NewReader("")
+	if len(call.Input) != 0 {
+		if ui.InputOf(fn).Kind == ui.InputText {
+			var text string
+			if err := json.Unmarshal(call.Input, &text); err != nil {
+				return nil, nil, nil, errors.New("input must be a string")
+			}
+
+			input = strings.NewReader(text)
+		} else {
+			input = strings.NewReader(string(call.Input))
+		}
+	}
+
+	return call.Args, flags, input, nil
+}
+
+// format returns giving value as flags expect it, with slices joined by commas.
+func format(value interface{}) string {
+	switch typed := value.(type) {
+	case []interface{}:
+		parts := make([]string, len(typed))
+		for index, item := range typed {
+			parts[index] = format(item)
+		}
+
+		return strings.Join(parts, ",")
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	}
+
+	return fmt.Sprint(value)
+}
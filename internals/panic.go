@@ -0,0 +1,97 @@
+package internals
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"strings"
+)
+
+// consts of panic error code and exit code.
+const (
+	CodePanic = "panic"
+	ExitPanic = 70
+)
+
+// PanicError is returned for functions which panicked.
+type PanicError struct {
+	Value interface{}
+	Stack []string
+}
+
+// Error implements the error interface.
+func (e PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Code implements the Coder interface.
+func (e PanicError) Code() string {
+	return CodePanic
+}
+
+// ExitCode implements the ExitCoder interface.
+func (e PanicError) ExitCode() int {
+	return ExitPanic
+}
+
+// Details implements the Detailer interface.
+func (e PanicError) Details() interface{} {
+	return map[string]interface{}{
+		"value": fmt.Sprintf("%+v", e.Value),
+		"stack": e.Stack,
+	}
+}
+
+// Recover recovers a panic of a function, setting err to a PanicError and
+// closing outgoing so written output is flushed. It must be deferred.
+func Recover(err *error, outgoing io.Closer) {
+	rec := recover()
+	if rec == nil {
+		return
+	}
+
+	*err = PanicError{Value: rec, Stack: trimStack(debug.Stack())}
+
+	if outgoing != nil {
+		outgoing.Close()
+	}
+}
+
+// trimStack returns the frames of giving stack from the panicking function
+// to the executor of generated binaries, as "function file:line" lines.
+func trimStack(stack []byte) []string {
+	lines := strings.Split(strings.TrimSpace(string(stack)), "\n")
+
+	// Frames are pairs of lines after the goroutine header, skip those up to the panic call.
+	start := 1
+	for index := 1; index+1 < len(lines); index += 2 {
+		if strings.HasPrefix(lines[index], "panic(") {
+			start = index + 2
+			break
+		}
+	}
+
+	var frames []string
+	for index := start; index+1 < len(lines); index += 2 {
+		frames = append(frames, lines[index]+" "+strings.TrimSpace(lines[index+1]))
+
+		if strings.Contains(lines[index], ".mainShogunExecuteContext(") {
+			break
+		}
+	}
+
+	return frames
+}
+
+// WithCrashOnPanic returns a new context which makes generated binaries
+// crash on panics of functions, instead of recovering them.
+func WithCrashOnPanic(ctx context.Context) context.Context {
+	return context.WithValue(ctx, crashOnPanicKey, true)
+}
+
+// CrashesOnPanic returns true/false if giving context was made with WithCrashOnPanic.
+func CrashesOnPanic(ctx context.Context) bool {
+	crash, _ := ctx.Value(crashOnPanicKey).(bool)
+	return crash
+}
@@ -0,0 +1,93 @@
+package internals
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+type closeRecorder struct {
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func explode(name string) {
+	var fields map[string]string
+	fields[name] = "boom"
+}
+
+// mainShogunExecuteContext stands in for the executor of generated binaries,
+// where stacks of recovered panics end.
+func mainShogunExecuteContext(out *closeRecorder) (err error) {
+	defer Recover(&err, out)
+
+	explode("name")
+	return nil
+}
+
+func TestRecover(t *testing.T) {
+	out := &closeRecorder{}
+
+	err := mainShogunExecuteContext(out)
+	if !out.closed {
+		t.Error("output was not closed after the panic")
+	}
+
+	panicked, ok := err.(PanicError)
+	if !ok {
+		t.Fatalf("recovered %#v, expected a PanicError", err)
+	}
+
+	if err.Error() != "panic: assignment to entry in nil map" {
+		t.Errorf("error message %q", err.Error())
+	}
+
+	stack := panicked.Stack
+	if len(stack) != 2 {
+		t.Fatalf("stack %q, expected frames of explode and the executor", stack)
+	}
+
+	if !strings.HasPrefix(stack[0], "github.com/influx6/shogun/internals.explode(") || !strings.Contains(stack[0], "panic_test.go:") {
+		t.Errorf("stack starts at %q, expected the panicking function", stack[0])
+	}
+
+	if !strings.Contains(stack[1], ".mainShogunExecuteContext(") {
+		t.Errorf("stack ends at %q, expected the executor", stack[1])
+	}
+
+	envelope := NewErrorEnvelope("Explode", err)
+	if envelope.Code != CodePanic || envelope.ExitCode != ExitPanic || envelope.Retryable {
+		t.Errorf("envelope of a panic is %+v", envelope)
+	}
+
+	if details := envelope.Details.(map[string]interface{}); details["value"] != "assignment to entry in nil map" {
+		t.Errorf("details hold value %q", details["value"])
+	}
+}
+
+func TestRecoverWithoutPanic(t *testing.T) {
+	out := &closeRecorder{}
+
+	err := func() (err error) {
+		defer Recover(&err, out)
+		return nil
+	}()
+
+	if err != nil || out.closed {
+		t.Errorf("Recover without a panic set %v, closing output: %t", err, out.closed)
+	}
+}
+
+func TestCrashesOnPanic(t *testing.T) {
+	if CrashesOnPanic(context.Background()) {
+		t.Error("functions crash on panics by default")
+	}
+
+	if !CrashesOnPanic(WithCrashOnPanic(context.Background())) {
+		t.Error("context of WithCrashOnPanic does not crash on panics")
+	}
+}
@@ -0,0 +1,324 @@
+// Package progress provides the reporter which generated binaries put into the
+// context of functions, drawing spinners and bars when stderr is a terminal or
+// writing JSON progress events.
+//
+//	task := progress.From(ctx).Start("download", total)
+//	defer task.Done()
+//
+//	task.Add(n)
+//
+// Without a reporter in the context, From returns nil whose tasks do nothing.
+package progress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// consts of reporting modes.
+const (
+	ModeAuto = "auto"
+	ModeTTY  = "tty"
+	ModeJSON = "json"
+	ModeNone = "none"
+)
+
+type contextKey string
+
+// consts of context keys.
+const (
+	reporterKey contextKey = "shogun:progress"
+)
+
+// spinner contains the frames drawn for running tasks.
+var spinner = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// barWidth sets the number of cells of drawn bars.
+const barWidth = 24
+
+// Event is written for changes of tasks in the json mode.
+type Event struct {
+	Event   string    `json:"event"`
+	Task    string    `json:"task"`
+	Current int64     `json:"current"`
+	Total   int64     `json:"total,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// Task is a unit of work whose progress is reported.
+type Task struct {
+	reporter *Reporter
+	name     string
+	total    int64
+	current  int64
+	started  time.Time
+	ended    time.Time
+	reported int64
+}
+
+// Add adds n to the amount of work done by the task.
+func (t *Task) Add(n int64) {
+	if t == nil {
+		return
+	}
+
+	t.reporter.mu.Lock()
+	defer t.reporter.mu.Unlock()
+
+	t.current += n
+}
+
+// Set sets the amount of work done by the task.
+func (t *Task) Set(n int64) {
+	if t == nil {
+		return
+	}
+
+	t.reporter.mu.Lock()
+	defer t.reporter.mu.Unlock()
+
+	t.current = n
+}
+
+// Done marks the task as finished. Only the first call has effect.
+func (t *Task) Done() {
+	if t == nil {
+		return
+	}
+
+	t.reporter.mu.Lock()
+	defer t.reporter.mu.Unlock()
+
+	if !t.ended.IsZero() {
+		return
+	}
+
+	t.ended = time.Now()
+	if t.total > 0 && t.current < t.total {
+		t.current = t.total
+	}
+}
+
+// Reporter reports the progress of tasks into a writer, redrawing them at an interval.
+type Reporter struct {
+	mu     sync.Mutex
+	w      io.Writer
+	mode   string
+	tasks  []*Task
+	frame  int
+	lines  int
+	closed bool
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New returns a new Reporter writing to w in giving mode, which is one of tty,
+// json or none.
+func New(w io.Writer, mode string) (*Reporter, error) {
+	interval := 100 * time.Millisecond
+
+	switch mode {
+	case ModeTTY:
+	case ModeJSON:
+		interval = 500 * time.Millisecond
+	case ModeNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("Unknown progress mode %q, expected %q, %q, %q or %q", mode, ModeAuto, ModeTTY, ModeJSON, ModeNone)
+	}
+
+	r := &Reporter{w: w, mode: mode, done: make(chan struct{})}
+
+	r.wg.Add(1)
+	go r.run(interval)
+
+	return r, nil
+}
+
+// Resolve returns the mode for giving file, where auto is tty if it is a terminal
+// and none otherwise.
+func Resolve(mode string, file *os.File) string {
+	if mode != ModeAuto && mode != "" {
+		return mode
+	}
+
+	stat, err := file.Stat()
+	if err != nil || stat.Mode()&os.ModeCharDevice == 0 {
+		return ModeNone
+	}
+
+	return ModeTTY
+}
+
+// With returns a new context which carries giving reporter.
+func With(ctx context.Context, r *Reporter) context.Context {
+	return context.WithValue(ctx, reporterKey, r)
+}
+
+// From returns the reporter within ctx, which is nil if it has none.
+func From(ctx context.Context) *Reporter {
+	r, _ := ctx.Value(reporterKey).(*Reporter)
+	return r
+}
+
+// Start returns a new task with giving name and total amount of work, where a
+// total of zero or less draws a spinner without a bar.
+func (r *Reporter) Start(name string, total int64) *Task {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	task := &Task{reporter: r, name: name, total: total, started: time.Now(), reported: -1}
+	if !r.closed {
+		r.tasks = append(r.tasks, task)
+	}
+
+	return task
+}
+
+// Close stops the reporter after drawing the last state of its tasks.
+func (r *Reporter) Close() {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return
+	}
+
+	r.closed = true
+	close(r.done)
+	r.mu.Unlock()
+
+	r.wg.Wait()
+}
+
+func (r *Reporter) run(interval time.Duration) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			r.report(true)
+			return
+		case <-ticker.C:
+			r.report(false)
+		}
+	}
+}
+
+// report writes the state of tasks, with all of them treated as ended if final.
+func (r *Reporter) report(final bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeJSON {
+		r.writeEvents(final)
+		return
+	}
+
+	r.draw(final)
+}
+
+// writeEvents writes an event for each task which started, changed or ended.
+func (r *Reporter) writeEvents(final bool) {
+	encoder := json.NewEncoder(r.w)
+
+	var active []*Task
+	for _, task := range r.tasks {
+		if task.reported == -1 {
+			encoder.Encode(Event{Event: "start", Task: task.name, Total: task.total, Time: task.started})
+			task.reported = 0
+		}
+
+		if !task.ended.IsZero() || final {
+			encoder.Encode(Event{Event: "done", Task: task.name, Current: task.current, Total: task.total, Time: time.Now()})
+			continue
+		}
+
+		if task.current != task.reported {
+			encoder.Encode(Event{Event: "progress", Task: task.name, Current: task.current, Total: task.total, Time: time.Now()})
+			task.reported = task.current
+		}
+
+		active = append(active, task)
+	}
+
+	r.tasks = active
+}
+
+// draw redraws the lines of tasks in place.
+func (r *Reporter) draw(final bool) {
+	var out strings.Builder
+
+	if r.lines > 0 {
+		fmt.Fprintf(&out, "\x1b[%dA", r.lines)
+	}
+
+	r.frame = (r.frame + 1) % len(spinner)
+
+	// Ended tasks are drawn first for the last time, so only active ones below them are redrawn.
+	var ended, active []*Task
+	for _, task := range r.tasks {
+		if !task.ended.IsZero() || final {
+			ended = append(ended, task)
+			continue
+		}
+
+		active = append(active, task)
+	}
+
+	for _, task := range ended {
+		out.WriteString("\r\x1b[2K" + task.line(spinner[r.frame], true) + "\n")
+	}
+
+	for _, task := range active {
+		out.WriteString("\r\x1b[2K" + task.line(spinner[r.frame], false) + "\n")
+	}
+
+	r.lines = len(active)
+	r.tasks = active
+
+	io.WriteString(r.w, out.String())
+}
+
+// line returns the drawn line of the task.
+func (t *Task) line(frame string, ended bool) string {
+	elapsed := time.Since(t.started)
+	if !t.ended.IsZero() {
+		elapsed = t.ended.Sub(t.started)
+	}
+
+	mark := frame
+	if ended {
+		mark = "⡿"
+	}
+
+	if t.total <= 0 {
+		return fmt.Sprintf("%s %s %d (%s)", mark, t.name, t.current, elapsed.Round(time.Millisecond*100))
+	}
+
+	ratio := float64(t.current) / float64(t.total)
+	if ratio > 1 {
+		ratio = 1
+	}
+
+	filled := int(ratio * barWidth)
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled)
+
+	return fmt.Sprintf("%s %s [%s] %3d%% %d/%d (%s)", mark, t.name, bar, int(ratio*100), t.current, t.total, elapsed.Round(time.Millisecond*100))
+}
@@ -0,0 +1,171 @@
+package progress
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// manual returns a reporter whose reports are made by the test rather than a ticker.
+func manual(mode string) (*Reporter, *bytes.Buffer) {
+	var out bytes.Buffer
+	return &Reporter{w: &out, mode: mode, done: make(chan struct{})}, &out
+}
+
+func events(t *testing.T, out *bytes.Buffer) []string {
+	t.Helper()
+
+	var got []string
+	decoder := json.NewDecoder(out)
+	for decoder.More() {
+		var event Event
+		if err := decoder.Decode(&event); err != nil {
+			t.Fatal(err)
+		}
+
+		got = append(got, fmt.Sprintf("%s %s %d/%d", event.Event, event.Task, event.Current, event.Total))
+	}
+
+	return got
+}
+
+func TestJSONEvents(t *testing.T) {
+	r, out := manual(ModeJSON)
+
+	download := r.Start("download", 10)
+	scan := r.Start("scan", 0)
+
+	download.Add(4)
+	r.report(false)
+
+	// Tasks without changes since the last report are left out.
+	download.Add(2)
+	r.report(false)
+
+	download.Done()
+	download.Done()
+	scan.Set(7)
+	r.report(false)
+	r.report(true)
+
+	expected := []string{
+		"start download 0/10",
+		"progress download 4/10",
+		"start scan 0/0",
+		"progress download 6/10",
+		"done download 10/10",
+		"progress scan 7/0",
+		"done scan 7/0",
+	}
+
+	if got := events(t, out); strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("events:\n%s\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestDrawnLines(t *testing.T) {
+	r, out := manual(ModeTTY)
+
+	upload := r.Start("upload", 4)
+	upload.Add(1)
+	r.report(false)
+
+	first := out.String()
+	if !strings.Contains(first, "upload [██████░░░░░░░░░░░░░░░░░░]  25% 1/4") || strings.Contains(first, "\x1b[1A") {
+		t.Errorf("first drawing %q", first)
+	}
+
+	out.Reset()
+	upload.Done()
+	r.report(false)
+
+	// The line of the task is redrawn in place, for the last time.
+	last := out.String()
+	if !strings.HasPrefix(last, "\x1b[1A\r\x1b[2K⡿ upload [████████████████████████] 100% 4/4") {
+		t.Errorf("last drawing %q", last)
+	}
+
+	out.Reset()
+	r.report(false)
+	if out.String() != "" {
+		t.Errorf("ended task was drawn again: %q", out.String())
+	}
+}
+
+func TestLine(t *testing.T) {
+	started := time.Now()
+
+	task := &Task{name: "index", current: 12, started: started, ended: started.Add(1520 * time.Millisecond)}
+	if line := task.line("⠙", false); line != "⠙ index 12 (1.5s)" {
+		t.Errorf("line of a task without total %q", line)
+	}
+
+	task.total = 8
+	if line := task.line("⠙", true); !strings.HasPrefix(line, "⡿ index [████████████████████████] 100% 12/8") {
+		t.Errorf("line of a task beyond its total %q", line)
+	}
+}
+
+func TestNilReporter(t *testing.T) {
+	r := From(context.Background())
+	if r != nil {
+		t.Fatalf("From returned %+v for a context without a reporter", r)
+	}
+
+	task := r.Start("anything", 3)
+	task.Add(1)
+	task.Set(2)
+	task.Done()
+	r.Close()
+
+	if none, err := New(os.Stderr, ModeNone); none != nil || err != nil {
+		t.Errorf("New of none mode returned %+v, %v", none, err)
+	}
+
+	if _, err := New(os.Stderr, "fancy"); err == nil {
+		t.Error("New accepted an unknown mode")
+	}
+}
+
+func TestCloseReportsTasks(t *testing.T) {
+	var out bytes.Buffer
+
+	r, err := New(&out, ModeJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := With(context.Background(), r)
+	From(ctx).Start("build", 2).Add(1)
+
+	r.Close()
+	r.Close()
+
+	if got := events(t, &out); len(got) != 2 || got[1] != "done build 1/2" {
+		t.Errorf("closed reporter wrote %q", got)
+	}
+
+	if task := r.Start("late", 1); task == nil {
+		t.Error("Start of a closed reporter returned nil")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	file, err := os.CreateTemp(t.TempDir(), "stderr")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+
+	for mode, expected := range map[string]string{"": ModeNone, ModeAuto: ModeNone, ModeJSON: ModeJSON, ModeTTY: ModeTTY} {
+		if resolved := Resolve(mode, file); resolved != expected {
+			t.Errorf("Resolve(%q) of a file returned %q, expected %q", mode, resolved, expected)
+		}
+	}
+}
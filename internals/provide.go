@@ -0,0 +1,182 @@
+package internals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/influx6/shogun/internals/trace"
+)
+
+// Provider defines a function declared with a @provider annotation, whose result
+// is injected into functions with a parameter of its type.
+type Provider struct {
+	RealName string
+	Type     string
+	Deps     []string
+	Imports  []VarMeta
+}
+
+// ProviderFunc returns the value of a @provider function, resolving the values of
+// its own parameters from the scope within ctx.
+type ProviderFunc func(ctx context.Context) (interface{}, error)
+
+// Providers maps the types provided by @provider functions to them.
+type Providers map[string]ProviderFunc
+
+// Scope caches the values of providers for a single invocation.
+type Scope struct {
+	providers Providers
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	order   []interface{}
+}
+
+// entry holds the value of a provider, which is ready once done is closed.
+type entry struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// NewScope returns a new Scope for giving providers.
+func NewScope(providers Providers) *Scope {
+	return &Scope{
+		providers: providers,
+		entries:   make(map[string]*entry),
+	}
+}
+
+// WithScope returns a new context which carries giving scope.
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeKey, scope)
+}
+
+// Get returns the value of the provider for giving type, calling it once and
+// resolving its parameters first, so values are made in dependency order.
+// Concurrent calls for a type being resolved wait for its value, while the types
+// being resolved by a call are carried within ctx, so a provider depending on
+// itself fails rather than waiting on itself.
+func (s *Scope) Get(ctx context.Context, typ string) (interface{}, error) {
+	chain, _ := ctx.Value(resolvingKey).([]string)
+	for _, resolving := range chain {
+		if resolving == typ {
+			return nil, fmt.Errorf("@provider for type %q depends on itself through %q", typ, strings.Join(append(chain, typ), " -> "))
+		}
+	}
+
+	s.mu.Lock()
+	if current, ok := s.entries[typ]; ok {
+		s.mu.Unlock()
+
+		select {
+		case <-current.done:
+			return current.value, current.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	provider, ok := s.providers[typ]
+	if !ok {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("No @provider for type %q", typ)
+	}
+
+	current := &entry{done: make(chan struct{})}
+	s.entries[typ] = current
+	s.mu.Unlock()
+
+	resolving := append(append([]string(nil), chain...), typ)
+
+	spanCtx, span := trace.Start(context.WithValue(ctx, resolvingKey, resolving), "provide "+typ)
+	current.value, current.err = provider(spanCtx)
+	span.Fail(current.err)
+	span.End()
+
+	s.mu.Lock()
+	if current.err != nil {
+		// Failed providers are called again by later calls, though waiting ones get the error.
+		delete(s.entries, typ)
+	} else {
+		s.order = append(s.order, current.value)
+	}
+	s.mu.Unlock()
+
+	close(current.done)
+	return current.value, current.err
+}
+
+// Close closes the values which implement io.Closer in the reverse order they
+// were made, so values are closed before those they depend on.
+func (s *Scope) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var errs []error
+	for index := len(s.order) - 1; index >= 0; index-- {
+		if closer, ok := s.order[index].(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	s.order = nil
+	s.entries = make(map[string]*entry)
+	return errors.Join(errs...)
+}
+
+// Provide returns the value of the provider for giving type from the scope within ctx.
+func Provide[T any](ctx context.Context, typ string) (T, error) {
+	var zero T
+
+	scope, ok := ctx.Value(scopeKey).(*Scope)
+	if !ok {
+		return zero, fmt.Errorf("No scope of @provider values for type %q", typ)
+	}
+
+	value, err := scope.Get(ctx, typ)
+	if err != nil {
+		return zero, err
+	}
+
+	provided, ok := value.(T)
+	if !ok {
+		return zero, fmt.Errorf("@provider for type %q returned %T", typ, value)
+	}
+
+	return provided, nil
+}
+
+// ProvidedList returns the variables of the function's provided parameters, like `provided0, provided1`.
+func (f Function) ProvidedList() string {
+	names := make([]string, len(f.Provided))
+	for index := range f.Provided {
+		names[index] = fmt.Sprintf("provided%d", index)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// ProvidedPrefix returns ProvidedList followed by a comma, if the function has provided parameters.
+func (f Function) ProvidedPrefix() string {
+	if len(f.Provided) == 0 {
+		return ""
+	}
+
+	return f.ProvidedList() + ", "
+}
+
+// ProvidedSuffix returns ProvidedList preceded by a comma, if the function has provided parameters.
+func (f Function) ProvidedSuffix() string {
+	if len(f.Provided) == 0 {
+		return ""
+	}
+
+	return ", " + f.ProvidedList()
+}
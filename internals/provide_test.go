@@ -0,0 +1,151 @@
+package internals
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type db struct {
+	name   string
+	closed *[]string
+}
+
+func (d *db) Close() error {
+	*d.closed = append(*d.closed, d.name)
+	return nil
+}
+
+type repo struct {
+	*db
+}
+
+func TestScope(t *testing.T) {
+	var calls int32
+	var closed []string
+
+	providers := Providers{
+		"*db": func(ctx context.Context) (interface{}, error) {
+			atomic.AddInt32(&calls, 1)
+			time.Sleep(10 * time.Millisecond)
+			return &db{name: "db", closed: &closed}, nil
+		},
+		"*repo": func(ctx context.Context) (interface{}, error) {
+			conn, err := Provide[*db](ctx, "*db")
+			if err != nil {
+				return nil, err
+			}
+
+			return &repo{db: &db{name: "repo of " + conn.name, closed: &closed}}, nil
+		},
+	}
+
+	scope := NewScope(providers)
+	ctx := WithScope(context.Background(), scope)
+
+	var wg sync.WaitGroup
+	repos := make([]*repo, 10)
+	for index := range repos {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+
+			var err error
+			if repos[index], err = Provide[*repo](ctx, "*repo"); err != nil {
+				t.Errorf("Provide returned %v", err)
+			}
+		}(index)
+	}
+
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("provider of *db was called %d times, expected once per scope", calls)
+	}
+
+	for _, got := range repos {
+		if got != repos[0] {
+			t.Fatalf("calls got different values of *repo")
+		}
+	}
+
+	if err := scope.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(closed, ", ") != "repo of db, db" {
+		t.Errorf("values closed in order %q, expected dependents first", closed)
+	}
+
+	// A closed scope makes new values for later calls.
+	if _, err := Provide[*db](ctx, "*db"); err != nil || calls != 2 {
+		t.Errorf("Provide after Close returned %v with %d calls", err, calls)
+	}
+}
+
+func TestScopeFailures(t *testing.T) {
+	var attempts int
+
+	scope := NewScope(Providers{
+		"A": func(ctx context.Context) (interface{}, error) { return Provide[string](ctx, "B") },
+		"B": func(ctx context.Context) (interface{}, error) { return Provide[string](ctx, "A") },
+		"Self": func(ctx context.Context) (interface{}, error) {
+			return Provide[string](ctx, "Self")
+		},
+		"Flaky": func(ctx context.Context) (interface{}, error) {
+			if attempts++; attempts == 1 {
+				return nil, errors.New("connection refused")
+			}
+
+			return "connected", nil
+		},
+		"Number": func(ctx context.Context) (interface{}, error) { return 42, nil },
+	})
+
+	ctx := WithScope(context.Background(), scope)
+
+	tests := []struct {
+		typ string
+		err string
+	}{
+		{typ: "A", err: `@provider for type "A" depends on itself through "A -> B -> A"`},
+		{typ: "Self", err: `@provider for type "Self" depends on itself through "Self -> Self"`},
+		{typ: "Missing", err: `No @provider for type "Missing"`},
+		{typ: "Number", err: `@provider for type "Number" returned int`},
+		{typ: "Flaky", err: "connection refused"},
+		{typ: "Flaky"},
+	}
+
+	for _, test := range tests {
+		_, err := Provide[string](ctx, test.typ)
+
+		var got string
+		if err != nil {
+			got = err.Error()
+		}
+
+		if got != test.err {
+			t.Errorf("Provide of %s returned %q, expected %q", test.typ, got, test.err)
+		}
+	}
+
+	if _, err := Provide[string](context.Background(), "A"); err == nil || err.Error() != `No scope of @provider values for type "A"` {
+		t.Errorf("Provide without a scope returned %v", err)
+	}
+}
+
+func TestProvidedParameters(t *testing.T) {
+	fn := Function{}
+	if fn.ProvidedPrefix() != "" || fn.ProvidedSuffix() != "" {
+		t.Errorf("function without provided parameters has prefix %q and suffix %q", fn.ProvidedPrefix(), fn.ProvidedSuffix())
+	}
+
+	fn.Provided = make([]VarMeta, 2)
+	if fn.ProvidedPrefix() != "provided0, provided1, " || fn.ProvidedSuffix() != ", provided0, provided1" {
+		t.Errorf("function with provided parameters has prefix %q and suffix %q", fn.ProvidedPrefix(), fn.ProvidedSuffix())
+	}
+}
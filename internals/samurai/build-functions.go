@@ -64,6 +64,26 @@ func (pn BuildList) Default() []internals.Function {
 	return nil
 }
 
+// HooksFor returns the hooks of giving kind, like `@before`, from all functions.
+func (pn BuildList) HooksFor(kind string) []internals.Hook {
+	var hooks []internals.Hook
+	for _, fm := range pn.Functions {
+		hooks = append(hooks, fm.HooksFor(kind)...)
+	}
+
+	return hooks
+}
+
+// Providers returns the @provider functions of all functions.
+func (pn BuildList) Providers() []internals.Provider {
+	var providers []internals.Provider
+	for _, fm := range pn.Functions {
+		providers = append(providers, fm.Providers...)
+	}
+
+	return providers
+}
+
 // HasGoogleImports returns true/false if any part of the function uses faux context.
 func (pn BuildList) HasGoogleImports() bool {
 	for _, item := range pn.Functions {
@@ -263,15 +283,23 @@ func Build(b BuildPackager, ctx build.Context, buildMetrics metrics.Metrics, com
 	fnPkg.FilePath = pkgItem.FilePath
 	fnPkg.Hash = string(pkgHash)
 
+	providers, err := pullProviders(pkgItem)
+	if err != nil {
+		return list, err
+	}
+
+	fnPkg.Providers = sortedProviders(providers)
+
 	for _, declr := range pkgItem.Packages {
 		// Retrieve function list if we are not to ingore file declr.
 		if !declr.HasAnnotation("@shogunIgnoreFunctions") {
-			fnsList, err := pullFunctionFromDeclr(pkgItem, &declr)
+			fnsList, hooks, err := pullFunctionFromDeclr(pkgItem, &declr, providers)
 			if err != nil {
 				return list, err
 			}
 
 			fnPkg.List = append(fnPkg.List, fnsList...)
+			fnPkg.Hooks = append(fnPkg.Hooks, hooks...)
 		}
 
 		source := strings.Replace(declr.Source, strings.Join(declr.Comments, "\n"), "", -1)
@@ -299,6 +327,14 @@ func Build(b BuildPackager, ctx build.Context, buildMetrics metrics.Metrics, com
 		})
 	}
 
+	// Binaries with a main dispatch their commands before functions, which
+	// would be shadowed by commands of the same name.
+	if !b.NoMain {
+		if err := checkReserved(fnPkg, b.Subs); err != nil {
+			return list, err
+		}
+	}
+
 	fnPkg.MaxNameLen = maxName(fnPkg)
 	list.Functions = append(list.Functions, fnPkg)
 
@@ -513,3 +549,21 @@ func generateFileHash(file string) (string, error) {
 
 	return fmt.Sprintf("%x", hasher.Sum(nil)), nil
 }
+
+// checkReserved returns an error if a function or sub-package of giving package
+// is named after one of the commands of generated binaries.
+func checkReserved(fnPkg internals.PackageFunctions, subs map[string]BuildList) error {
+	for _, fn := range fnPkg.List {
+		if internals.IsReserved(fn.Name) {
+			return fmt.Errorf("ReservedName(Function: %q): %q is a command of the binary, functions can not use any of %s", fn.RealName, fn.Name, strings.Join(internals.ReservedNames, ", "))
+		}
+	}
+
+	for _, sub := range subs {
+		if internals.IsReserved(sub.BinaryName) {
+			return fmt.Errorf("ReservedName(Package: %q): %q is a command of the binary, sub-packages can not use any of %s", sub.Path, sub.BinaryName, strings.Join(internals.ReservedNames, ", "))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package samurai
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/influx6/shogun/internals"
+	"github.com/influx6/shogun/templates"
+)
+
+// commandNames matches the names of the top level commands of the main template,
+// leaving out flags and subcommands which are indented further.
+var commandNames = regexp.MustCompile(`(?m)^\t\t\tName:\s+"([\w-]+)",$`)
+
+func TestReservedNamesMatchCommands(t *testing.T) {
+	var commands []string
+	for _, match := range commandNames.FindAllStringSubmatch(string(templates.Must("shogun-src-pkg-main.tml")), -1) {
+		commands = append(commands, match[1])
+	}
+
+	reserved := append([]string(nil), internals.ReservedNames...)
+
+	sort.Strings(commands)
+	sort.Strings(reserved)
+
+	if strings.Join(commands, ",") != strings.Join(reserved, ",") {
+		t.Fatalf("binaries have commands %q, but ReservedNames contains %q", commands, reserved)
+	}
+}
+
+func TestCheckReserved(t *testing.T) {
+	fns := func(names ...string) internals.PackageFunctions {
+		var pkg internals.PackageFunctions
+		for _, name := range names {
+			pkg.List = append(pkg.List, internals.Function{RealName: name, Name: strings.ToLower(name)})
+		}
+		return pkg
+	}
+
+	if err := checkReserved(fns("Greet", "Server", "Flows"), map[string]BuildList{"demo/sub": {BinaryName: "sub"}}); err != nil {
+		t.Errorf("names close to commands were rejected: %s", err)
+	}
+
+	err := checkReserved(fns("Greet", "Serve"), nil)
+	if err == nil || !strings.Contains(err.Error(), `ReservedName(Function: "Serve")`) {
+		t.Errorf("function Serve was not rejected, got %v", err)
+	}
+
+	err = checkReserved(fns("Greet"), map[string]BuildList{"demo/worker": {Path: "demo/worker", BinaryName: "worker"}})
+	if err == nil || !strings.Contains(err.Error(), `ReservedName(Package: "demo/worker")`) {
+		t.Errorf("sub-package worker was not rejected, got %v", err)
+	}
+}
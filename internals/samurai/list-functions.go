@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"go/doc"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/influx6/faux/metrics"
@@ -16,14 +19,17 @@ import (
 	"github.com/influx6/moz/ast"
 	"github.com/influx6/moz/gen"
 	"github.com/influx6/shogun/internals"
+	"github.com/influx6/shogun/internals/schedule"
 	"github.com/influx6/shogun/templates"
 )
 
 const (
-	defaultDesc        = "No description provided."
-	fauxContext        = "context"
-	googleContext      = "context"
-	flagAnnotationName = "flag"
+	defaultDesc         = "No description provided."
+	fauxContext         = "context"
+	googleContext       = "context"
+	flagAnnotationName  = "flag"
+	defaultDebounce     = 300 * time.Millisecond
+	defaultRetryInitial = time.Second
 )
 
 // errors.
@@ -179,13 +185,30 @@ func pullFunctions(pkg ast.Package) (internals.PackageFunctions, error) {
 		fnPkg.BinaryName = pkg.Name
 	}
 
+	providers, err := pullProviders(pkg)
+	if err != nil {
+		return fnPkg, err
+	}
+
+	fnPkg.Providers = sortedProviders(providers)
+
 	for _, declr := range pkg.Packages {
 		if declr.HasAnnotation("@shogunIgnoreFunctions") {
 			continue
 		}
 
 		for _, function := range declr.Functions {
-			fn, ignore, err := pullFunction(&function, &declr)
+			hook, isHook, err := pullHook(&function, &declr)
+			if err != nil {
+				return fnPkg, err
+			}
+
+			if isHook {
+				fnPkg.Hooks = append(fnPkg.Hooks, hook)
+				continue
+			}
+
+			fn, ignore, err := pullFunction(&function, &declr, providers)
 			if err != nil {
 				return fnPkg, err
 			}
@@ -203,14 +226,25 @@ func pullFunctions(pkg ast.Package) (internals.PackageFunctions, error) {
 	return fnPkg, nil
 }
 
-// pullFunctionFromDeclr returns all function details within the giving PackageDeclaration.
-func pullFunctionFromDeclr(pkg ast.Package, declr *ast.PackageDeclaration) ([]internals.Function, error) {
+// pullFunctionFromDeclr returns all function and hook details within the giving PackageDeclaration.
+func pullFunctionFromDeclr(pkg ast.Package, declr *ast.PackageDeclaration, providers map[string]internals.Provider) ([]internals.Function, []internals.Hook, error) {
 	var list []internals.Function
+	var hooks []internals.Hook
 
 	for _, function := range declr.Functions {
-		fn, ignore, err := pullFunction(&function, declr)
+		hook, isHook, err := pullHook(&function, declr)
+		if err != nil {
+			return list, hooks, err
+		}
+
+		if isHook {
+			hooks = append(hooks, hook)
+			continue
+		}
+
+		fn, ignore, err := pullFunction(&function, declr, providers)
 		if err != nil {
-			return list, err
+			return list, hooks, err
 		}
 
 		if ignore {
@@ -220,17 +254,135 @@ func pullFunctionFromDeclr(pkg ast.Package, declr *ast.PackageDeclaration) ([]in
 		list = append(list, fn)
 	}
 
-	return list, nil
+	return list, hooks, nil
 }
 
-func pullFunction(function *ast.FuncDeclaration, declr *ast.PackageDeclaration) (internals.Function, bool, error) {
+// splitProvided returns the arguments of giving function without those whose types
+// have a @provider, which must follow the function's context, and the provided ones.
+func splitProvided(def ast.FunctionDefinition, providers map[string]internals.Provider) ([]ast.ArgType, []internals.VarMeta, error) {
+	start := 0
+	if len(def.Args) != 0 && matchTypes(def.Args[:1], []string{"context.Context"}) {
+		start = 1
+	}
+
+	end := start
+	for end < len(def.Args) {
+		if _, ok := providers[def.Args[end].Type]; !ok {
+			break
+		}
+
+		end++
+	}
+
+	for _, arg := range def.Args[end:] {
+		if _, ok := providers[arg.Type]; ok {
+			return nil, nil, fmt.Errorf("InvalidProvided(Function: %q): parameters of provided types must follow the context, found %q", def.Name, arg.Type)
+		}
+	}
+
+	var provided []internals.VarMeta
+	for _, arg := range def.Args[start:end] {
+		provided = append(provided, importOf(arg))
+	}
+
+	args := append(append([]ast.ArgType{}, def.Args[:start]...), def.Args[end:]...)
+	return args, provided, nil
+}
+
+// hookSignatures contains the argument and return types expected of each kind of hook.
+var hookSignatures = map[string]struct {
+	args    []string
+	returns []string
+	format  string
+}{
+	internals.HookBefore: {
+		args:    []string{"context.Context", "string"},
+		returns: []string{"context.Context", "error"},
+		format:  "func(ctx context.Context, cmd string) (context.Context, error)",
+	},
+	internals.HookAfter: {
+		args:   []string{"context.Context", "string", "error", "time.Duration"},
+		format: "func(ctx context.Context, cmd string, err error, took time.Duration)",
+	},
+	internals.HookSetup: {
+		args:    []string{"context.Context"},
+		returns: []string{"error"},
+		format:  "func(ctx context.Context) error",
+	},
+	internals.HookTeardown: {
+		args:    []string{"context.Context"},
+		returns: []string{"error"},
+		format:  "func(ctx context.Context) error",
+	},
+}
+
+// pullHook returns the hook of a function declared with a @before, @after, @setup
+// or @teardown annotation, which is hidden from the commands of the binary.
+func pullHook(function *ast.FuncDeclaration, declr *ast.PackageDeclaration) (internals.Hook, bool, error) {
+	var hook internals.Hook
+
+	if !function.Exported || function.HasAnnotation("@ignore") {
+		return hook, false, nil
+	}
+
+	for _, kind := range internals.HookKinds {
+		if !function.HasAnnotation(kind) {
+			continue
+		}
+
+		if hook.Kind != "" {
+			return hook, true, fmt.Errorf("InvalidHook(Function: %q): only one of @before, @after, @setup or @teardown is allowed", function.FuncName)
+		}
+
+		hook.Kind = kind
+	}
+
+	if hook.Kind == "" {
+		return hook, false, nil
+	}
+
+	def, err := function.Definition(declr)
+	if err != nil {
+		return hook, true, err
+	}
+
+	signature := hookSignatures[hook.Kind]
+	if !matchTypes(def.Args, signature.args) || !matchTypes(def.Returns, signature.returns) {
+		return hook, true, fmt.Errorf("InvalidHook(Function: %q): expected format %s for %s", def.Name, signature.format, hook.Kind)
+	}
+
+	hook.RealName = def.Name
+	return hook, true, nil
+}
+
+// matchTypes returns true/false if giving arguments have the types in order, with
+// contexts required to be from the standard library.
+func matchTypes(args []ast.ArgType, types []string) bool {
+	if len(args) != len(types) {
+		return false
+	}
+
+	for index, arg := range args {
+		if arg.Type != types[index] {
+			return false
+		}
+
+		if arg.Type == "context.Context" && arg.Import.Path != googleContext {
+			return false
+		}
+	}
+
+	return true
+}
+
+func pullFunction(function *ast.FuncDeclaration, declr *ast.PackageDeclaration, providers map[string]internals.Provider) (internals.Function, bool, error) {
 	var fn internals.Function
 
 	if !function.Exported {
 		return fn, true, nil
 	}
 
-	if function.HasAnnotation("@ignore") {
+	if function.HasAnnotation("@ignore") || function.HasAnnotation("@provider") {
 		return fn, true, nil
 	}
 
@@ -239,6 +391,11 @@ func pullFunction(function *ast.FuncDeclaration, declr *ast.PackageDeclaration)
 		return fn, true, err
 	}
 
+	def.Args, fn.Provided, err = splitProvided(def, providers)
+	if err != nil {
+		return fn, true, err
+	}
+
 	argLen := len(def.Args)
 	retLen := len(def.Returns)
 
@@ -306,6 +463,134 @@ func pullFunction(function *ast.FuncDeclaration, declr *ast.PackageDeclaration)
 		})
 	}
 
+	// Relative paths of @watch annotations are resolved against the package's directory.
+	pkgDir, err := filepath.Abs(filepath.Dir(declr.FilePath))
+	if err != nil {
+		return fn, true, err
+	}
+
+	for _, watchAnnotation := range function.AnnotationsFor("@watch") {
+		params := joinedParams(watchAnnotation)
+
+		watch := internals.Watch{
+			Path:     params["path"],
+			Glob:     params["glob"],
+			Dir:      pkgDir,
+			Debounce: defaultDebounce,
+		}
+
+		if watch.Path == "" {
+			return fn, true, fmt.Errorf("InvalidWatch(Function: %q): expected format @watch(path => ./assets, glob => *.scss, debounce => 300ms)", def.Name)
+		}
+
+		if debounce := params["debounce"]; debounce != "" {
+			watch.Debounce, err = time.ParseDuration(debounce)
+			if err != nil {
+				return fn, true, fmt.Errorf("InvalidWatch(Function: %q): invalid debounce: %+q", def.Name, err)
+			}
+		}
+
+		fn.Watches = append(fn.Watches, watch)
+	}
+
+	for _, cronAnnotation := range function.AnnotationsFor("@cron") {
+		params := joinedParams(cronAnnotation)
+
+		cron := internals.Cron{
+			Expr:     params["expr"],
+			Overlap:  params["overlap"],
+			Location: params["tz"],
+		}
+
+		if cron.Expr == "" {
+			return fn, true, fmt.Errorf("InvalidCron(Function: %q): expected format @cron(expr => \"*/5 * * * *\", overlap => skip, jitter => 10s, tz => UTC)", def.Name)
+		}
+
+		if _, err := schedule.Parse(cron.Expr); err != nil {
+			return fn, true, fmt.Errorf("InvalidCron(Function: %q): %s", def.Name, err)
+		}
+
+		switch cron.Overlap {
+		case "", schedule.Skip, schedule.Queue, schedule.Allow:
+		default:
+			return fn, true, fmt.Errorf("InvalidCron(Function: %q): overlap must be one of skip, queue or allow", def.Name)
+		}
+
+		if cron.Location != "" {
+			if _, err := time.LoadLocation(cron.Location); err != nil {
+				return fn, true, fmt.Errorf("InvalidCron(Function: %q): invalid tz: %+q", def.Name, err)
+			}
+		}
+
+		if jitter := params["jitter"]; jitter != "" {
+			cron.Jitter, err = time.ParseDuration(jitter)
+			if err != nil {
+				return fn, true, fmt.Errorf("InvalidCron(Function: %q): invalid jitter: %+q", def.Name, err)
+			}
+		}
+
+		fn.Crons = append(fn.Crons, cron)
+	}
+
+	if timeoutAnnotations := function.AnnotationsFor("@timeout"); len(timeoutAnnotations) != 0 {
+		timeout := joinedParams(timeoutAnnotations[0])["duration"]
+		if timeout == "" && len(timeoutAnnotations[0].Arguments) != 0 {
+			timeout = strings.Trim(timeoutAnnotations[0].Arguments[0], `"`)
+		}
+
+		fn.Timeout, err = time.ParseDuration(timeout)
+		if err != nil || fn.Timeout <= 0 {
+			return fn, true, fmt.Errorf("InvalidTimeout(Function: %q): expected format @timeout(5m)", def.Name)
+		}
+	}
+
+	if retryAnnotations := function.AnnotationsFor("@retry"); len(retryAnnotations) != 0 {
+		params := joinedParams(retryAnnotations[0])
+
+		retry := internals.Retry{
+			Backoff: internals.BackoffExponential,
+			Initial: defaultRetryInitial,
+			On:      internals.RetryOnRetryable,
+		}
+
+		retry.Attempts, err = strconv.Atoi(params["attempts"])
+		if err != nil || retry.Attempts < 1 {
+			return fn, true, fmt.Errorf("InvalidRetry(Function: %q): expected format @retry(attempts => 3, backoff => exponential, initial => 1s, max => 30s, on => retryable)", def.Name)
+		}
+
+		if backoff := params["backoff"]; backoff != "" {
+			switch backoff {
+			case internals.BackoffConstant, internals.BackoffLinear, internals.BackoffExponential:
+				retry.Backoff = backoff
+			default:
+				return fn, true, fmt.Errorf("InvalidRetry(Function: %q): backoff must be one of constant, linear or exponential", def.Name)
+			}
+		}
+
+		if on := params["on"]; on != "" {
+			switch on {
+			case internals.RetryOnRetryable, internals.RetryOnAny:
+				retry.On = on
+			default:
+				return fn, true, fmt.Errorf("InvalidRetry(Function: %q): on must be one of retryable or any", def.Name)
+			}
+		}
+
+		if initial := params["initial"]; initial != "" {
+			if retry.Initial, err = time.ParseDuration(initial); err != nil {
+				return fn, true, fmt.Errorf("InvalidRetry(Function: %q): invalid initial: %+q", def.Name, err)
+			}
+		}
+
+		if max := params["max"]; max != "" {
+			if retry.Max, err = time.ParseDuration(max); err != nil {
+				return fn, true, fmt.Errorf("InvalidRetry(Function: %q): invalid max: %+q", def.Name, err)
+			}
+		}
+
+		fn.Retry = &retry
+	}
+
 	fn.Flags = flags
 	fn.RealName = def.Name
 	fn.Type = argumentType
@@ -368,6 +653,32 @@ func pullFunction(function *ast.FuncDeclaration, declr *ast.PackageDeclaration)
 
 var ioWriteCloser = "io.WriteCloser"
 
+// joinedParams returns the unquoted parameters of giving annotation, joining back values
+// which contain commas, like cron lists, which are split apart as arguments.
+func joinedParams(annotation ast.AnnotationDeclaration) map[string]string {
+	params := make(map[string]string)
+
+	var last string
+	for _, part := range annotation.Arguments {
+		pieces := strings.SplitN(part, "=>", 2)
+		if len(pieces) == 2 {
+			last = strings.TrimSpace(pieces[0])
+			params[last] = strings.TrimSpace(pieces[1])
+			continue
+		}
+
+		if last != "" {
+			params[last] += "," + part
+		}
+	}
+
+	for key, value := range params {
+		params[key] = strings.Trim(value, `"`)
+	}
+
+	return params
+}
+
 func getArgumentsState(arg ast.ArgType, arg2 *ast.ArgType) (internals.ArgType, internals.VarMeta) {
 	switch arg.Type {
 	case "[]string":
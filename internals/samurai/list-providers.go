@@ -0,0 +1,112 @@
+package samurai
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influx6/moz/ast"
+	"github.com/influx6/shogun/internals"
+)
+
+// pullProviders returns the functions declared with a @provider annotation within
+// the giving package, by the type they provide, after checking every parameter of
+// a provider has a provider and none depend on themselves.
+func pullProviders(pkg ast.Package) (map[string]internals.Provider, error) {
+	providers := make(map[string]internals.Provider)
+
+	for _, declr := range pkg.Packages {
+		if declr.HasAnnotation("@shogunIgnoreFunctions") {
+			continue
+		}
+
+		for _, function := range declr.Functions {
+			if !function.Exported || !function.HasAnnotation("@provider") || function.HasAnnotation("@ignore") {
+				continue
+			}
+
+			def, err := function.Definition(&declr)
+			if err != nil {
+				return providers, err
+			}
+
+			if len(def.Args) == 0 || !matchTypes(def.Args[:1], []string{"context.Context"}) ||
+				len(def.Returns) != 2 || def.Returns[1].Type != "error" {
+				return providers, fmt.Errorf("InvalidProvider(Function: %q): expected format func(ctx context.Context, deps ...) (T, error)", def.Name)
+			}
+
+			provider := internals.Provider{
+				RealName: def.Name,
+				Type:     def.Returns[0].Type,
+				Imports:  []internals.VarMeta{importOf(def.Returns[0])},
+			}
+
+			for _, arg := range def.Args[1:] {
+				provider.Deps = append(provider.Deps, arg.Type)
+				provider.Imports = append(provider.Imports, importOf(arg))
+			}
+
+			if other, ok := providers[provider.Type]; ok {
+				return providers, fmt.Errorf("InvalidProvider(Function: %q): type %q is already provided by %q", def.Name, provider.Type, other.RealName)
+			}
+
+			providers[provider.Type] = provider
+		}
+	}
+
+	for _, provider := range providers {
+		if err := checkProvider(providers, provider, nil); err != nil {
+			return providers, err
+		}
+	}
+
+	return providers, nil
+}
+
+// checkProvider returns an error if a parameter of giving provider has no provider,
+// or if it depends on a provider within the chain of those depending on it.
+func checkProvider(providers map[string]internals.Provider, provider internals.Provider, chain []string) error {
+	for _, name := range chain {
+		if name == provider.RealName {
+			return fmt.Errorf("InvalidProvider(Function: %q): depends on itself through %q", provider.RealName, chain)
+		}
+	}
+
+	chain = append(chain, provider.RealName)
+
+	for _, dep := range provider.Deps {
+		depProvider, ok := providers[dep]
+		if !ok {
+			return fmt.Errorf("InvalidProvider(Function: %q): no @provider for parameter type %q", provider.RealName, dep)
+		}
+
+		if err := checkProvider(providers, depProvider, chain); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sortedProviders returns the giving providers ordered by the type they provide.
+func sortedProviders(providers map[string]internals.Provider) []internals.Provider {
+	list := make([]internals.Provider, 0, len(providers))
+	for _, provider := range providers {
+		list = append(list, provider)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Type < list[j].Type
+	})
+
+	return list
+}
+
+// importOf returns the import of giving argument's type.
+func importOf(arg ast.ArgType) internals.VarMeta {
+	return internals.VarMeta{
+		Type:       arg.Type,
+		TypeAddr:   arg.ExType,
+		Import:     arg.Import.Path,
+		ImportNick: arg.Import.Name,
+	}
+}
@@ -0,0 +1,247 @@
+// Package schedule provides a parser for cron expressions and a scheduler which
+// calls functions declared with @cron annotations on their schedules.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// macros contains the expressions for supported cron descriptors.
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// field describes the range and names allowed for a field of a cron expression.
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minutes = field{name: "minute", min: 0, max: 59}
+	hours   = field{name: "hour", min: 0, max: 23}
+	days    = field{name: "day of month", min: 1, max: 31}
+	months  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	weekdays = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// Expression is a parsed cron expression of the standard five fields:
+// minute, hour, day of month, month and day of week.
+type Expression struct {
+	minute   uint64
+	hour     uint64
+	dom      uint64
+	month    uint64
+	dow      uint64
+	anyDays  bool
+	anyWeeks bool
+}
+
+// Parse returns the Expression for giving cron expression, which is either made
+// up of the standard five fields or one of the @yearly, @monthly, @weekly, @daily,
+// @midnight and @hourly descriptors.
+func Parse(expr string) (Expression, error) {
+	var exp Expression
+
+	spec := strings.TrimSpace(expr)
+	if macro, ok := macros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return exp, fmt.Errorf("cron expression %q must have 5 fields, got %d", expr, len(fields))
+	}
+
+	var err error
+	if exp.minute, err = parseField(fields[0], minutes); err != nil {
+		return exp, err
+	}
+
+	if exp.hour, err = parseField(fields[1], hours); err != nil {
+		return exp, err
+	}
+
+	if exp.dom, err = parseField(fields[2], days); err != nil {
+		return exp, err
+	}
+
+	if exp.month, err = parseField(fields[3], months); err != nil {
+		return exp, err
+	}
+
+	if exp.dow, err = parseField(fields[4], weekdays); err != nil {
+		return exp, err
+	}
+
+	// Sunday can be written as both 0 and 7.
+	if exp.dow&(1<<7) != 0 {
+		exp.dow = (exp.dow | 1) &^ (1 << 7)
+	}
+
+	exp.anyDays = strings.HasPrefix(fields[2], "*")
+	exp.anyWeeks = strings.HasPrefix(fields[4], "*")
+
+	if !exp.possible() {
+		return exp, fmt.Errorf("cron expression %q never matches any date", expr)
+	}
+
+	return exp, nil
+}
+
+// possibleYears is the number of years after which both days of the week and leap
+// years repeat, between 1901 and 2099.
+const possibleYears = 28
+
+// possible returns true/false if any day matches the expression, which fails for
+// days missing from all of its months, like the 31st of April.
+func (e Expression) possible() bool {
+	day := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for end := day.AddDate(possibleYears, 0, 0); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if e.month&(1<<uint(day.Month())) != 0 && e.matchDay(day) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Next returns the first time after giving time which matches the expression, in
+// the location of giving time. A zero time is returned if none exists within 28 years,
+// which does not happen for expressions returned by Parse.
+func (e Expression) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+
+	limit := t.AddDate(possibleYears, 0, 0)
+	for t.Before(limit) {
+		if e.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !e.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if e.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if e.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// matchDay returns true/false if the day of giving time matches the expression. As
+// with cron, when both day fields are restricted a day matching either is enough.
+func (e Expression) matchDay(t time.Time) bool {
+	dom := e.dom&(1<<uint(t.Day())) != 0
+	dow := e.dow&(1<<uint(t.Weekday())) != 0
+
+	if e.anyDays || e.anyWeeks {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// parseField returns the bitset of values of giving field, supporting lists,
+// ranges, steps and names.
+func parseField(value string, f field) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(value, ",") {
+		step := 1
+		rng := part
+
+		if index := strings.IndexByte(part, '/'); index != -1 {
+			rng = part[:index]
+
+			var err error
+			if step, err = strconv.Atoi(part[index+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", part, f.name)
+			}
+		}
+
+		low, high := f.min, f.max
+
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			pieces := strings.SplitN(rng, "-", 2)
+
+			var err error
+			if low, err = parseValue(pieces[0], f); err != nil {
+				return 0, err
+			}
+
+			if high, err = parseValue(pieces[1], f); err != nil {
+				return 0, err
+			}
+		default:
+			var err error
+			if low, err = parseValue(rng, f); err != nil {
+				return 0, err
+			}
+
+			// A single value without a step matches only itself, else it starts the step.
+			if rng == part {
+				high = low
+			}
+		}
+
+		if low > high {
+			return 0, fmt.Errorf("invalid range %q in %s field", part, f.name)
+		}
+
+		for value := low; value <= high; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+
+	return bits, nil
+}
+
+// parseValue returns the number or named value of a field.
+func parseValue(value string, f field) (int, error) {
+	if number, ok := f.names[strings.ToLower(value)]; ok {
+		return number, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, f.name)
+	}
+
+	if number < f.min || number > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d-%d] in %s field", number, f.min, f.max, f.name)
+	}
+
+	return number, nil
+}
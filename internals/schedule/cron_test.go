@@ -0,0 +1,114 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr string
+		err  string
+	}{
+		{expr: "*/5 * * * *"},
+		{expr: "@daily"},
+		{expr: "0 9-17 * * mon-fri"},
+		{expr: "0 0 29 2 *"},
+		{expr: "0 0 31 4 1"},
+		{expr: "0 0 31 4 *", err: `cron expression "0 0 31 4 *" never matches any date`},
+		{expr: "0 0 30 2 *", err: `cron expression "0 0 30 2 *" never matches any date`},
+		{expr: "0 0 31 2,4,6 *", err: `cron expression "0 0 31 2,4,6 *" never matches any date`},
+		{expr: "* * *", err: `cron expression "* * *" must have 5 fields, got 3`},
+		{expr: "60 * * * *", err: "value 60 out of range [0-59] in minute field"},
+		{expr: "*/0 * * * *", err: `invalid step "*/0" in minute field`},
+		{expr: "0 0 5-1 * *", err: `invalid range "5-1" in day of month field`},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.expr)
+
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("Parse(%q) failed: %s", test.expr, err)
+		case test.err != "" && err == nil:
+			t.Errorf("Parse(%q) succeeded, expected %q", test.expr, test.err)
+		case test.err != "" && !strings.Contains(err.Error(), test.err):
+			t.Errorf("Parse(%q) failed with %q, expected %q", test.expr, err, test.err)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	// A Sunday.
+	from := time.Date(2026, time.October, 18, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		next time.Time
+	}{
+		{
+			name: "every minute",
+			expr: "* * * * *",
+			next: time.Date(2026, time.October, 18, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			name: "day of week with any day of month",
+			expr: "0 12 * * 1",
+			next: time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month with any day of week",
+			expr: "30 6 15 * *",
+			next: time.Date(2026, time.November, 15, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "either day field when both are set, day of month first",
+			expr: "0 0 20 * 5",
+			next: time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "either day field when both are set, day of week first",
+			expr: "0 0 13 * 5",
+			next: time.Date(2026, time.October, 23, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "both day fields when day of month starts with a star",
+			expr: "0 0 */10 * 3",
+			next: time.Date(2026, time.October, 21, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 0 * * 7",
+			next: time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekday hours",
+			expr: "*/15 9-17 * * mon-fri",
+			next: time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "macro",
+			expr: "@yearly",
+			next: time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "leap day",
+			expr: "0 0 29 2 *",
+			next: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		exp, err := Parse(test.expr)
+		if err != nil {
+			t.Errorf("%s: Parse(%q) failed: %s", test.name, test.expr, err)
+			continue
+		}
+
+		if next := exp.Next(from); !next.Equal(test.next) {
+			t.Errorf("%s: Next of %q is %s, expected %s", test.name, test.expr, next, test.next)
+		}
+	}
+}
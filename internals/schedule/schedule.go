@@ -0,0 +1,286 @@
+package schedule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// consts of overlap policies, deciding what happens when a function is due while
+// its previous run is still going.
+const (
+	Skip  = "skip"
+	Queue = "queue"
+	Allow = "allow"
+)
+
+// consts of run statuses.
+const (
+	Succeeded = "succeeded"
+	Failed    = "failed"
+	Skipped   = "skipped"
+)
+
+// maxQueued sets the number of runs which can wait for a function using the queue
+// policy, before further runs are skipped.
+const maxQueued = 16
+
+// Runner defines a function which calls giving function for a scheduled run,
+// writing its output into output.
+type Runner func(ctx context.Context, fn internals.ShogunFunc, output io.Writer) error
+
+// Record contains the details of a scheduled run, logged as a JSON line.
+type Record struct {
+	Function  string    `json:"function"`
+	Binary    string    `json:"binary"`
+	Expr      string    `json:"expr"`
+	Status    string    `json:"status"`
+	Scheduled time.Time `json:"scheduled"`
+	Started   time.Time `json:"started"`
+	Ended     time.Time `json:"ended"`
+	Duration  string    `json:"duration"`
+	Output    string    `json:"output,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Scheduler calls functions on the schedules of their @cron annotations.
+type Scheduler struct {
+	// Call is called for every run of a function.
+	Call Runner
+
+	// Log receives a JSON line Record for every run, including skipped ones.
+	Log io.Writer
+
+	// Location, Jitter and Overlap are used for annotations which do not set their own.
+	Location *time.Location
+	Jitter   time.Duration
+	Overlap  string
+
+	lm     sync.Mutex
+	rm     sync.Mutex
+	random *rand.Rand
+}
+
+// job is a single @cron annotation of a function.
+type job struct {
+	fn       internals.ShogunFunc
+	cron     internals.Cron
+	expr     Expression
+	location *time.Location
+	overlap  string
+	jitter   time.Duration
+	running  int32
+	queue    chan time.Time
+}
+
+// Run calls the giving functions on their schedules until the context is
+// cancelled, after which it waits for running calls to end.
+func (s *Scheduler) Run(ctx context.Context, fns []internals.ShogunFunc) error {
+	s.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	var jobs []*job
+	for _, fn := range fns {
+		for _, cron := range fn.Crons {
+			jb, err := s.jobFor(fn, cron)
+			if err != nil {
+				return err
+			}
+
+			jobs = append(jobs, jb)
+		}
+	}
+
+	var waiter sync.WaitGroup
+	for _, jb := range jobs {
+		if jb.overlap == Queue {
+			waiter.Add(1)
+			go func(jb *job) {
+				defer waiter.Done()
+				for scheduled := range jb.queue {
+					s.call(ctx, jb, scheduled)
+				}
+			}(jb)
+		}
+
+		waiter.Add(1)
+		go func(jb *job) {
+			defer waiter.Done()
+			s.loop(ctx, jb, &waiter)
+		}(jb)
+	}
+
+	waiter.Wait()
+	return ctx.Err()
+}
+
+// jobFor returns a job for giving annotation, filling unset values from the Scheduler.
+func (s *Scheduler) jobFor(fn internals.ShogunFunc, cron internals.Cron) (*job, error) {
+	expr, err := Parse(cron.Expr)
+	if err != nil {
+		return nil, fmt.Errorf("Function %q: %s", fn.Name, err)
+	}
+
+	jb := &job{
+		fn:       fn,
+		cron:     cron,
+		expr:     expr,
+		location: s.Location,
+		overlap:  s.Overlap,
+		jitter:   s.Jitter,
+	}
+
+	if cron.Location != "" {
+		if jb.location, err = time.LoadLocation(cron.Location); err != nil {
+			return nil, fmt.Errorf("Function %q: %s", fn.Name, err)
+		}
+	}
+
+	if jb.location == nil {
+		jb.location = time.Local
+	}
+
+	if cron.Overlap != "" {
+		jb.overlap = cron.Overlap
+	}
+
+	switch jb.overlap {
+	case "":
+		jb.overlap = Skip
+	case Skip, Queue, Allow:
+	default:
+		return nil, fmt.Errorf("Function %q: unknown overlap policy %q, expected skip, queue or allow", fn.Name, jb.overlap)
+	}
+
+	if cron.Jitter != 0 {
+		jb.jitter = cron.Jitter
+	}
+
+	if jb.overlap == Queue {
+		jb.queue = make(chan time.Time, maxQueued)
+	}
+
+	return jb, nil
+}
+
+// loop waits for each due time of the job, dispatching it based on the job's overlap policy.
+func (s *Scheduler) loop(ctx context.Context, jb *job, waiter *sync.WaitGroup) {
+	if jb.queue != nil {
+		defer close(jb.queue)
+	}
+
+	for {
+		scheduled := jb.expr.Next(time.Now().In(jb.location))
+		if scheduled.IsZero() {
+			return
+		}
+
+		timer := time.NewTimer(time.Until(scheduled) + s.jitterFor(jb))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		switch jb.overlap {
+		case Allow:
+			waiter.Add(1)
+			go func() {
+				defer waiter.Done()
+				s.call(ctx, jb, scheduled)
+			}()
+		case Queue:
+			select {
+			case jb.queue <- scheduled:
+			default:
+				s.log(s.record(jb, scheduled, Skipped, "queue is full"))
+			}
+		default:
+			if !atomic.CompareAndSwapInt32(&jb.running, 0, 1) {
+				s.log(s.record(jb, scheduled, Skipped, "previous run is still running"))
+				continue
+			}
+
+			waiter.Add(1)
+			go func() {
+				defer waiter.Done()
+				defer atomic.StoreInt32(&jb.running, 0)
+				s.call(ctx, jb, scheduled)
+			}()
+		}
+	}
+}
+
+// call runs the function of the job, logging its Record.
+func (s *Scheduler) call(ctx context.Context, jb *job, scheduled time.Time) {
+	// Queued runs are dropped once the scheduler is stopped.
+	if ctx.Err() != nil {
+		return
+	}
+
+	rec := s.record(jb, scheduled, Succeeded, "")
+
+	var output bytes.Buffer
+	err := s.Call(ctx, jb.fn, &output)
+
+	rec.Ended = time.Now()
+	rec.Duration = rec.Ended.Sub(rec.Started).String()
+	rec.Output = output.String()
+
+	if err != nil {
+		rec.Status = Failed
+		rec.Error = err.Error()
+	}
+
+	s.log(rec)
+}
+
+// record returns a new Record for a run of giving job.
+func (s *Scheduler) record(jb *job, scheduled time.Time, status string, message string) Record {
+	now := time.Now()
+
+	return Record{
+		Function:  jb.fn.Name,
+		Binary:    jb.fn.Binary,
+		Expr:      jb.cron.Expr,
+		Status:    status,
+		Scheduled: scheduled,
+		Started:   now,
+		Ended:     now,
+		Duration:  "0s",
+		Error:     message,
+	}
+}
+
+// log writes giving Record as a JSON line into the Scheduler's Log.
+func (s *Scheduler) log(rec Record) {
+	if s.Log == nil {
+		return
+	}
+
+	s.lm.Lock()
+	defer s.lm.Unlock()
+
+	json.NewEncoder(s.Log).Encode(rec)
+}
+
+// jitterFor returns a random delay within the job's jitter.
+func (s *Scheduler) jitterFor(jb *job) time.Duration {
+	if jb.jitter <= 0 {
+		return 0
+	}
+
+	s.rm.Lock()
+	defer s.rm.Unlock()
+
+	return time.Duration(s.random.Int63n(int64(jb.jitter)))
+}
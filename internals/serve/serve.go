@@ -0,0 +1,318 @@
+// Package serve exposes the functions of a generated binary as HTTP endpoints,
+// where request bodies are their input and responses their output.
+package serve
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// consts of request headers.
+const (
+	// FlagHeaderPrefix prefixes headers whose values are set as flags, like `X-Flag-Loud: true`.
+	FlagHeaderPrefix = "X-Flag-"
+
+	// InvocationHeader carries the ID of an invocation, which is taken from requests if set.
+	InvocationHeader = "X-Invocation-Id"
+
+	// ErrorTrailer carries the error envelope of functions which failed after writing output.
+	ErrorTrailer = "X-Error"
+)
+
+// DefaultMaxBody is the limit of request bodies used unless set otherwise.
+const DefaultMaxBody = 10 << 20
+
+// StatusCoder is implemented by errors which set the HTTP status of their responses.
+type StatusCoder interface {
+	StatusCode() int
+}
+
+// Caller calls giving function with its arguments, flags, input and output.
+type Caller func(ctx context.Context, fn internals.ShogunFunc, args []string, flags []string, input io.Reader, output io.WriteCloser) error
+
+// Server serves functions as `POST /<fn>` for those of the binary, and `POST /<sub>/<fn>`
+// for those of its subcommands.
+type Server struct {
+	Binary    string
+	Functions []internals.ShogunFunc
+	Call      Caller
+
+	// Token requires requests to carry a `Authorization: Bearer <token>` header if set.
+	Token string
+
+	// MaxBody limits the bytes read from request bodies if above zero.
+	MaxBody int64
+
+	// Concurrency limits the number of functions called at once if above zero,
+	// rejecting other requests with 429 Too Many Requests.
+	Concurrency int
+
+	slots    chan struct{}
+	draining int32
+}
+
+// Path returns the path which serves giving function.
+func (s *Server) Path(fn internals.ShogunFunc) string {
+	if fn.Binary == "" || fn.Binary == s.Binary {
+		return "/" + fn.NS
+	}
+
+	return "/" + fn.Binary + "/" + fn.NS
+}
+
+// Drain makes the readiness endpoint fail, so load balancers stop sending
+// requests before the server shuts down.
+func (s *Server) Drain() {
+	atomic.StoreInt32(&s.draining, 1)
+}
+
+// Handler returns the http.Handler serving the functions, with `GET /healthz`
+// and `GET /readyz` endpoints and `GET /` listing the served functions.
+func (s *Server) Handler() http.Handler {
+	if s.Concurrency > 0 {
+		s.slots = make(chan struct{}, s.Concurrency)
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "ok\n")
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&s.draining) == 1 {
+			http.Error(w, "draining", http.StatusServiceUnavailable)
+			return
+		}
+
+		io.WriteString(w, "ok\n")
+	})
+
+	mux.Handle("/", s.authorize(http.HandlerFunc(s.list)))
+
+	for _, fn := range s.Functions {
+		mux.Handle(s.Path(fn), s.authorize(s.function(fn)))
+	}
+
+	return mux
+}
+
+// authorize rejects requests without the server's bearer token, if it has one.
+func (s *Server) authorize(next http.Handler) http.Handler {
+	if s.Token == "" {
+		return next
+	}
+
+	expected := []byte("Bearer " + s.Token)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// list writes the served functions with their paths and flags.
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+
+	type endpoint struct {
+		Path     string   `json:"path"`
+		Function string   `json:"function"`
+		Binary   string   `json:"binary"`
+		Flags    []string `json:"flags,omitempty"`
+	}
+
+	endpoints := make([]endpoint, 0, len(s.Functions))
+	for _, fn := range s.Functions {
+		item := endpoint{Path: s.Path(fn), Function: fn.Name, Binary: fn.Binary}
+		for _, flag := range fn.Flags {
+			item.Flags = append(item.Flags, flag.Name)
+		}
+
+		endpoints = append(endpoints, item)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(endpoints)
+}
+
+// function returns the handler which calls giving function.
+func (s *Server) function(fn internals.ShogunFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if s.slots != nil {
+			select {
+			case s.slots <- struct{}{}:
+				defer func() { <-s.slots }()
+			default:
+				w.Header().Set("Retry-After", "1")
+				http.Error(w, "too many requests", http.StatusTooManyRequests)
+				return
+			}
+		}
+
+		id := r.Header.Get(InvocationHeader)
+		if id == "" {
+			id = internals.NewInvocationID()
+		}
+
+		w.Header().Set(InvocationHeader, id)
+		w.Header().Set("Trailer", ErrorTrailer)
+
+		body := io.Reader(r.Body)
+		if s.MaxBody > 0 {
+			body = http.MaxBytesReader(w, r.Body, s.MaxBody)
+		}
+
+		ctx := internals.WithInvocationID(r.Context(), id)
+		output := &responseWriter{w: w}
+
+		err := s.Call(ctx, fn, r.URL.Query()["arg"], Flags(fn, r), body, output)
+		if err == nil {
+			output.Close()
+			return
+		}
+
+		envelope := internals.NewErrorEnvelope(fn.Name, err)
+		data, _ := json.Marshal(envelope)
+
+		// Once output was written the status is sent, so the error goes into the trailer.
+		if output.written {
+			w.Header().Set(ErrorTrailer, string(data))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(Status(err, envelope))
+		w.Write(append(data, '\n'))
+	})
+}
+
+// Flags returns the flags of giving function set by the request, from query values
+// like `?loud=true` and headers like `X-Flag-Loud: true`, in that order.
+func Flags(fn internals.ShogunFunc, r *http.Request) []string {
+	var flags []string
+	query := r.URL.Query()
+
+	for _, flag := range fn.Flags {
+		if values, ok := query[flag.Name]; ok && len(values) != 0 {
+			flags = append(flags, flag.Name+"="+values[0])
+			continue
+		}
+
+		if value := r.Header.Get(FlagHeaderPrefix + flag.Name); value != "" {
+			flags = append(flags, flag.Name+"="+value)
+		}
+	}
+
+	return flags
+}
+
+// Status returns the HTTP status for the error of a function and its envelope.
+func Status(err error, envelope internals.ErrorEnvelope) int {
+	var coder StatusCoder
+	if errors.As(err, &coder) {
+		return coder.StatusCode()
+	}
+
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return http.StatusRequestEntityTooLarge
+	}
+
+	switch envelope.Code {
+	case internals.CodeInvalidInput:
+		return http.StatusBadRequest
+	case internals.CodeTimeout:
+		return http.StatusGatewayTimeout
+	case internals.CodeCancelled:
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusInternalServerError
+}
+
+// IsCGI returns true/false if the process was started by a web server as a CGI
+// script, which sets GATEWAY_INTERFACE and gives no arguments but the search words
+// of queries without an `=`. Processes which inherit the variable, like commands run
+// by CGI scripts, are not taken for one when given other arguments.
+func IsCGI(args []string) bool {
+	if os.Getenv("GATEWAY_INTERFACE") == "" {
+		return false
+	}
+
+	if len(args) == 0 {
+		return true
+	}
+
+	query := os.Getenv("QUERY_STRING")
+	if query == "" || strings.Contains(query, "=") {
+		return false
+	}
+
+	words := strings.Split(query, "+")
+	if len(words) != len(args) {
+		return false
+	}
+
+	for index, word := range words {
+		if decoded, err := url.QueryUnescape(word); err != nil || decoded != args[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// responseWriter writes the output of a function into a response, noting if any was written.
+type responseWriter struct {
+	w       http.ResponseWriter
+	written bool
+}
+
+// Write implements the io.Writer interface.
+func (rw *responseWriter) Write(data []byte) (int, error) {
+	if len(data) != 0 {
+		rw.written = true
+	}
+
+	return rw.w.Write(data)
+}
+
+// Close implements the io.Closer interface, flushing written output. Without
+// output nothing is flushed, as flushing sends the status and headers which the
+// error of a failed function would set.
+func (rw *responseWriter) Close() error {
+	if !rw.written {
+		return nil
+	}
+
+	if flusher, ok := rw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
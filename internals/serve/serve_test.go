@@ -0,0 +1,207 @@
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influx6/shogun/internals"
+)
+
+var greet = internals.ShogunFunc{
+	NS:     "greet",
+	Name:   "Greet",
+	Binary: "demo",
+	Flags:  internals.Flags{{Name: "loud"}},
+}
+
+// serveWith returns the handler of a server calling call for the greet function.
+func serveWith(server Server, call Caller) http.Handler {
+	server.Binary = "demo"
+	server.Functions = []internals.ShogunFunc{greet}
+	server.Call = call
+	return server.Handler()
+}
+
+func post(handler http.Handler, target string, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, target, strings.NewReader(body)))
+	return w
+}
+
+func envelopeOf(t *testing.T, data string) internals.ErrorEnvelope {
+	t.Helper()
+
+	var envelope internals.ErrorEnvelope
+	if err := json.Unmarshal([]byte(data), &envelope); err != nil {
+		t.Fatalf("invalid error envelope %q: %s", data, err)
+	}
+
+	return envelope
+}
+
+func TestFunctionOutput(t *testing.T) {
+	handler := serveWith(Server{}, func(ctx context.Context, fn internals.ShogunFunc, args []string, flags []string, input io.Reader, output io.WriteCloser) error {
+		data, _ := io.ReadAll(input)
+		id, _ := internals.InvocationID(ctx)
+		fmt.Fprintf(output, "hello %s %q %q %s", data, args, flags, id)
+		return output.Close()
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/greet?arg=a&arg=b&loud=true", strings.NewReader("bat"))
+	req.Header.Set(InvocationHeader, "inv-1")
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d, expected 200", w.Code)
+	}
+
+	if expected := `hello bat ["a" "b"] ["loud=true"] inv-1`; w.Body.String() != expected {
+		t.Errorf("body %q, expected %q", w.Body.String(), expected)
+	}
+
+	if id := w.Header().Get(InvocationHeader); id != "inv-1" {
+		t.Errorf("invocation header %q, expected inv-1", id)
+	}
+}
+
+func TestFunctionFailures(t *testing.T) {
+	t.Run("panic before output", func(t *testing.T) {
+		handler := serveWith(Server{}, func(ctx context.Context, fn internals.ShogunFunc, args []string, flags []string, input io.Reader, output io.WriteCloser) (err error) {
+			defer internals.Recover(&err, output)
+			panic("boom")
+		})
+
+		w := post(handler, "/greet", "")
+		if w.Code != http.StatusInternalServerError {
+			t.Fatalf("status %d, expected 500", w.Code)
+		}
+
+		if envelope := envelopeOf(t, w.Body.String()); envelope.Function != "Greet" || !strings.Contains(envelope.Message, "boom") {
+			t.Errorf("unexpected envelope %+v", envelope)
+		}
+	})
+
+	t.Run("error after output goes into the trailer", func(t *testing.T) {
+		handler := serveWith(Server{}, func(ctx context.Context, fn internals.ShogunFunc, args []string, flags []string, input io.Reader, output io.WriteCloser) error {
+			io.WriteString(output, "partial")
+			output.Close()
+			return errors.New("disk full")
+		})
+
+		w := post(handler, "/greet", "")
+		if w.Code != http.StatusOK || w.Body.String() != "partial" {
+			t.Fatalf("status %d with %q, expected 200 with partial output", w.Code, w.Body.String())
+		}
+
+		if envelope := envelopeOf(t, w.Result().Trailer.Get(ErrorTrailer)); envelope.Message != "disk full" {
+			t.Errorf("unexpected trailer envelope %+v", envelope)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		handler := serveWith(Server{}, func(ctx context.Context, fn internals.ShogunFunc, args []string, flags []string, input io.Reader, output io.WriteCloser) error {
+			return context.DeadlineExceeded
+		})
+
+		if w := post(handler, "/greet", ""); w.Code != http.StatusGatewayTimeout {
+			t.Errorf("status %d, expected 504", w.Code)
+		}
+	})
+
+	t.Run("body above the limit", func(t *testing.T) {
+		handler := serveWith(Server{MaxBody: 4}, func(ctx context.Context, fn internals.ShogunFunc, args []string, flags []string, input io.Reader, output io.WriteCloser) error {
+			_, err := io.ReadAll(input)
+			return err
+		})
+
+		if w := post(handler, "/greet", "too large"); w.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("status %d, expected 413", w.Code)
+		}
+	})
+}
+
+func TestRequestsRejected(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	handler := serveWith(Server{Token: "secret", Concurrency: 1}, func(ctx context.Context, fn internals.ShogunFunc, args []string, flags []string, input io.Reader, output io.WriteCloser) error {
+		close(started)
+		<-release
+		return nil
+	})
+
+	if w := post(handler, "/greet", ""); w.Code != http.StatusUnauthorized {
+		t.Errorf("request without token got %d, expected 401", w.Code)
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/greet", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("GET without token got %d, expected 401", w.Code)
+	}
+
+	authorized := func(method string) *http.Request {
+		req := httptest.NewRequest(method, "/greet", nil)
+		req.Header.Set("Authorization", "Bearer secret")
+		return req
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, authorized(http.MethodGet))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET got %d, expected 405", w.Code)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(httptest.NewRecorder(), authorized(http.MethodPost))
+	}()
+
+	<-started
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, authorized(http.MethodPost))
+	if w.Code != http.StatusTooManyRequests {
+		t.Errorf("request above concurrency got %d, expected 429", w.Code)
+	}
+
+	close(release)
+	<-done
+}
+
+func TestIsCGI(t *testing.T) {
+	tests := []struct {
+		gateway string
+		query   string
+		args    []string
+		cgi     bool
+	}{
+		{gateway: "CGI/1.1", cgi: true},
+		{gateway: "CGI/1.1", query: "name=bat", cgi: true},
+		{gateway: "CGI/1.1", query: "big+red%21", args: []string{"big", "red!"}, cgi: true},
+		{gateway: "CGI/1.1", query: "big+red", args: []string{"serve"}},
+		{gateway: "CGI/1.1", query: "name=bat", args: []string{"greet"}},
+		{gateway: "CGI/1.1", args: []string{"greet", "bat"}},
+		{args: []string{"greet"}},
+		{},
+	}
+
+	for _, test := range tests {
+		t.Setenv("GATEWAY_INTERFACE", test.gateway)
+		t.Setenv("QUERY_STRING", test.query)
+
+		if cgi := IsCGI(test.args); cgi != test.cgi {
+			t.Errorf("IsCGI(%q) with GATEWAY_INTERFACE=%q and QUERY_STRING=%q returned %t", test.args, test.gateway, test.query, cgi)
+		}
+	}
+}
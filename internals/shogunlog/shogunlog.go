@@ -0,0 +1,90 @@
+// Package shogunlog provides the leveled structured logger which generated binaries
+// put into the context of functions, writing to stderr so it never mixes with
+// output meant for pipes.
+//
+//	shogunlog.From(ctx).Info("uploading", "files", len(files))
+//
+// Records carry the name of the function and the ID of its invocation.
+package shogunlog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// consts of log formats.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+type contextKey string
+
+// consts of context keys.
+const (
+	loggerKey contextKey = "shogun:logger"
+)
+
+// New returns a new logger writing records at or above giving level to w in giving format.
+func New(w io.Writer, level slog.Level, format string) (*slog.Logger, error) {
+	options := &slog.HandlerOptions{Level: level}
+
+	switch strings.ToLower(format) {
+	case FormatText, "":
+		return slog.New(slog.NewTextHandler(w, options)), nil
+	case FormatJSON:
+		return slog.New(slog.NewJSONHandler(w, options)), nil
+	}
+
+	return nil, fmt.Errorf("Unknown log format %q, expected %q or %q", format, FormatText, FormatJSON)
+}
+
+// Level returns the level of giving name, like `debug` or `warn`. Without a name,
+// verbosity of 1 returns info, 2 and above returns debug, and otherwise warn.
+func Level(name string, verbosity int) (slog.Level, error) {
+	if name != "" {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(name)); err != nil {
+			return level, fmt.Errorf("Unknown log level %q, expected debug, info, warn or error", name)
+		}
+
+		return level, nil
+	}
+
+	switch {
+	case verbosity >= 2:
+		return slog.LevelDebug, nil
+	case verbosity == 1:
+		return slog.LevelInfo, nil
+	}
+
+	return slog.LevelWarn, nil
+}
+
+// With returns a new context which carries giving logger.
+func With(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey, logger)
+}
+
+// From returns the logger within ctx, or a text logger writing warnings and errors
+// to stderr if it has none.
+func From(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey).(*slog.Logger); ok {
+		return logger
+	}
+
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+}
+
+// ForFunction returns a new context whose logger adds the name of giving function
+// and the ID of its invocation to records.
+func ForFunction(ctx context.Context, function string) context.Context {
+	id, _ := internals.InvocationID(ctx)
+	return With(ctx, From(ctx).With("function", function, "invocation", id))
+}
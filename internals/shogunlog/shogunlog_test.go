@@ -0,0 +1,86 @@
+package shogunlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/influx6/shogun/internals"
+)
+
+func TestLevel(t *testing.T) {
+	tests := []struct {
+		name      string
+		verbosity int
+		level     slog.Level
+		fails     bool
+	}{
+		{verbosity: 0, level: slog.LevelWarn},
+		{verbosity: 1, level: slog.LevelInfo},
+		{verbosity: 3, level: slog.LevelDebug},
+		{name: "error", verbosity: 2, level: slog.LevelError},
+		{name: "DEBUG", level: slog.LevelDebug},
+		{name: "loud", fails: true},
+	}
+
+	for _, test := range tests {
+		level, err := Level(test.name, test.verbosity)
+		if (err != nil) != test.fails || (!test.fails && level != test.level) {
+			t.Errorf("Level(%q, %d) returned %s, %v", test.name, test.verbosity, level, err)
+		}
+	}
+}
+
+func TestFunctionRecords(t *testing.T) {
+	var out bytes.Buffer
+
+	logger, err := New(&out, slog.LevelInfo, "JSON")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := internals.WithInvocationID(With(context.Background(), logger), "inv-1")
+	ctx = ForFunction(ctx, "Upload")
+
+	From(ctx).Debug("skipped")
+	From(ctx).Info("uploading", "files", 3)
+	From(context.Background()).Info("not ours")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("logged %q, expected only the info record", lines)
+	}
+
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{"level": "INFO", "msg": "uploading", "function": "Upload", "invocation": "inv-1", "files": float64(3)}
+	for key, value := range expected {
+		if record[key] != value {
+			t.Errorf("record has %s %v, expected %v", key, record[key], value)
+		}
+	}
+}
+
+func TestNewFormats(t *testing.T) {
+	var out bytes.Buffer
+
+	logger, err := New(&out, slog.LevelWarn, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger.Warn("disk almost full", "free", "2%")
+	if line := out.String(); !strings.Contains(line, `level=WARN msg="disk almost full" free=2%`) {
+		t.Errorf("text record %q", line)
+	}
+
+	if _, err := New(&out, slog.LevelWarn, "xml"); err == nil {
+		t.Error("New accepted an unknown format")
+	}
+}
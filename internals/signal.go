@@ -0,0 +1,86 @@
+package internals
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// Cancellation cancels a context on SIGINT or SIGTERM, forcing an exit if the
+// function using it does not return within a grace period or a second signal arrives.
+type Cancellation struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	signals   chan os.Signal
+	done      chan struct{}
+	signalled int32
+}
+
+// CancelOnSignal returns a new Cancellation for a context derived from parent.
+// The force function is called with the reason when the grace period passes or
+// a second signal arrives, and should exit. A grace of zero waits without limit.
+func CancelOnSignal(parent context.Context, grace time.Duration, force func(reason string)) *Cancellation {
+	ctx, cancel := context.WithCancel(parent)
+
+	cn := &Cancellation{
+		ctx:     ctx,
+		cancel:  cancel,
+		signals: make(chan os.Signal, 2),
+		done:    make(chan struct{}),
+	}
+
+	signal.Notify(cn.signals, os.Interrupt, syscall.SIGTERM)
+	go cn.wait(grace, force)
+
+	return cn
+}
+
+// Context returns the context which is cancelled on the first signal.
+func (cn *Cancellation) Context() context.Context {
+	return cn.ctx
+}
+
+// Signalled returns true/false if a signal cancelled the context.
+func (cn *Cancellation) Signalled() bool {
+	return atomic.LoadInt32(&cn.signalled) == 1
+}
+
+// Stop stops watching for signals and releases the context.
+func (cn *Cancellation) Stop() {
+	signal.Stop(cn.signals)
+	close(cn.done)
+	cn.cancel()
+}
+
+func (cn *Cancellation) wait(grace time.Duration, force func(reason string)) {
+	var sig os.Signal
+
+	select {
+	case <-cn.done:
+		return
+	case sig = <-cn.signals:
+	}
+
+	atomic.StoreInt32(&cn.signalled, 1)
+	cn.cancel()
+
+	var expired <-chan time.Time
+	if grace > 0 {
+		timer := time.NewTimer(grace)
+		defer timer.Stop()
+
+		expired = timer.C
+	}
+
+	select {
+	case <-cn.done:
+	case second := <-cn.signals:
+		force(fmt.Sprintf("forced exit on second signal %q after %q", second, sig))
+	case <-expired:
+		force(fmt.Sprintf("forced exit as function did not return within %s of signal %q", grace, sig))
+	}
+}
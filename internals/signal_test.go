@@ -0,0 +1,105 @@
+//go:build !windows
+// +build !windows
+
+package internals
+
+import (
+	"context"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// forced records the reasons a Cancellation gives for forcing an exit.
+type forced chan string
+
+func (f forced) force(reason string) { f <- reason }
+
+func (f forced) expect(t *testing.T, reason string) {
+	t.Helper()
+
+	select {
+	case got := <-f:
+		if !strings.Contains(got, reason) {
+			t.Errorf("forced exit with %q, expected %q", got, reason)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("exit was not forced, expected %q", reason)
+	}
+}
+
+func (f forced) none(t *testing.T, wait time.Duration) {
+	t.Helper()
+
+	select {
+	case got := <-f:
+		t.Errorf("unexpected forced exit: %q", got)
+	case <-time.After(wait):
+	}
+}
+
+func signalled(t *testing.T, cn *Cancellation) {
+	t.Helper()
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-cn.Context().Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("context was not cancelled by the signal")
+	}
+
+	if !cn.Signalled() {
+		t.Error("Signalled returned false after a signal")
+	}
+}
+
+func TestCancelOnSignal(t *testing.T) {
+	t.Run("returning within the grace period", func(t *testing.T) {
+		exits := make(forced, 1)
+		cn := CancelOnSignal(context.Background(), time.Second, exits.force)
+
+		signalled(t, cn)
+		cn.Stop()
+		exits.none(t, 50*time.Millisecond)
+	})
+
+	t.Run("grace period passing", func(t *testing.T) {
+		exits := make(forced, 1)
+		cn := CancelOnSignal(context.Background(), 20*time.Millisecond, exits.force)
+		defer cn.Stop()
+
+		signalled(t, cn)
+		exits.expect(t, `did not return within 20ms of signal "terminated"`)
+	})
+
+	t.Run("second signal", func(t *testing.T) {
+		exits := make(forced, 1)
+		cn := CancelOnSignal(context.Background(), 0, exits.force)
+		defer cn.Stop()
+
+		signalled(t, cn)
+		exits.none(t, 50*time.Millisecond)
+
+		syscall.Kill(syscall.Getpid(), syscall.SIGINT)
+		exits.expect(t, `second signal "interrupt" after "terminated"`)
+	})
+
+	t.Run("stopped without a signal", func(t *testing.T) {
+		exits := make(forced, 1)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		cn := CancelOnSignal(ctx, time.Second, exits.force)
+		cn.Stop()
+
+		if cn.Context().Err() == nil || cn.Signalled() {
+			t.Errorf("stopped Cancellation has context error %v and Signalled %t", cn.Context().Err(), cn.Signalled())
+		}
+
+		exits.none(t, 20*time.Millisecond)
+	})
+}
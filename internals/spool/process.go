@@ -0,0 +1,14 @@
+//go:build !windows
+// +build !windows
+
+package spool
+
+import (
+	"syscall"
+)
+
+// alive returns true/false if a process with giving pid exists.
+func alive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
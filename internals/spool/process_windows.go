@@ -0,0 +1,16 @@
+package spool
+
+import (
+	"os"
+)
+
+// alive returns true/false if a process with giving pid exists.
+func alive(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	process.Release()
+	return true
+}
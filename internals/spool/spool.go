@@ -0,0 +1,375 @@
+// Package spool runs a function as a worker of a spool directory, where each file
+// placed into an inbox is the input of a call, whose output is written into an
+// outbox and whose failure moves the file into a failed directory.
+package spool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// ClaimedDir is the directory within the inbox which holds the files being processed,
+// each renamed to `<name>.<pid>.<id>` after the worker process which claimed it.
+const ClaimedDir = ".claimed"
+
+// ErrorSuffix is added to the name of a failed file for the file of its error envelope.
+const ErrorSuffix = ".error.json"
+
+// consts of file statuses.
+const (
+	Succeeded = "succeeded"
+	Failed    = "failed"
+	Returned  = "returned"
+)
+
+// errors.
+var (
+	// ErrNoDirs is returned by Run when the inbox, outbox or failed directory is not set.
+	ErrNoDirs = errors.New("inbox, outbox and failed directories must be set")
+)
+
+// Runner defines a function which calls the worker's function, reading input and
+// writing its output into output.
+type Runner func(ctx context.Context, input io.Reader, output io.Writer) error
+
+// Record contains the details of a processed file, logged as a JSON line.
+type Record struct {
+	File     string    `json:"file"`
+	Function string    `json:"function"`
+	Status   string    `json:"status"`
+	Attempts int       `json:"attempts"`
+	Started  time.Time `json:"started"`
+	Ended    time.Time `json:"ended"`
+	Duration string    `json:"duration"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// Worker claims the files of Inbox, calling Call with each of them as input.
+//
+// A file is claimed by renaming it into the ClaimedDir of the inbox under a name of
+// its own, so workers of the same host can share an inbox, and producers should write
+// files elsewhere, or with names starting with a dot, before renaming them into it.
+// Claims left behind by worker processes which are gone are returned into the inbox
+// when a worker starts. Output is written into Outbox under the
+// file's name once a call succeeds. Files whose calls fail after all attempts are
+// moved into Failed, next to their error envelope.
+type Worker struct {
+	Inbox  string
+	Outbox string
+	Failed string
+
+	// Function is the name of the function, used within error envelopes.
+	Function string
+
+	// Call is called for every attempt of a file.
+	Call Runner
+
+	// Concurrency sets the number of files processed at once, defaulting to 1.
+	Concurrency int
+
+	// Retry decides how failing calls are retried, where no attempts means a single one.
+	Retry internals.Retry
+
+	// Poll sets how often the inbox is read when it's empty, defaulting to a second.
+	Poll time.Duration
+
+	// Log receives a JSON line Record for every processed file.
+	Log io.Writer
+
+	lm sync.Mutex
+}
+
+// Run processes the files of the inbox until ctx is cancelled, after which it waits
+// for running calls to end. Calls cancelled by ctx return their files into the inbox,
+// for them to be processed again.
+func (w *Worker) Run(ctx context.Context) error {
+	if w.Inbox == "" || w.Outbox == "" || w.Failed == "" {
+		return ErrNoDirs
+	}
+
+	for _, dir := range []string{filepath.Join(w.Inbox, ClaimedDir), w.Outbox, w.Failed} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+
+	if err := w.reclaim(); err != nil {
+		return err
+	}
+
+	concurrency := w.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	poll := w.Poll
+	if poll <= 0 {
+		poll = time.Second
+	}
+
+	var waiter sync.WaitGroup
+	defer waiter.Wait()
+
+	// A slot is taken before claiming a file, so files are only claimed when they can be processed.
+	slots := make(chan struct{}, concurrency)
+
+	for {
+		names, err := w.pending()
+		if err != nil {
+			return err
+		}
+
+		var claimed int
+		for _, name := range names {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			path, ok := w.claim(name)
+			if !ok {
+				<-slots
+				continue
+			}
+
+			claimed++
+
+			waiter.Add(1)
+			go func(name string, path string) {
+				defer waiter.Done()
+				defer func() { <-slots }()
+
+				w.process(ctx, name, path)
+			}(name, path)
+		}
+
+		if claimed != 0 {
+			continue
+		}
+
+		timer := time.NewTimer(poll)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// pending returns the names of files waiting within the inbox, in order.
+func (w *Worker) pending() ([]string, error) {
+	entries, err := os.ReadDir(w.Inbox)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		names = append(names, entry.Name())
+	}
+
+	return names, nil
+}
+
+// claim returns the path of giving file once claimed, and false if another worker
+// claimed it first.
+func (w *Worker) claim(name string) (string, bool) {
+	path := filepath.Join(w.Inbox, ClaimedDir, fmt.Sprintf("%s.%d.%s", name, os.Getpid(), internals.NewInvocationID()))
+	return path, os.Rename(filepath.Join(w.Inbox, name), path) == nil
+}
+
+// reclaim returns the claimed files of worker processes which are gone into the inbox.
+func (w *Worker) reclaim() error {
+	entries, err := os.ReadDir(filepath.Join(w.Inbox, ClaimedDir))
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		name, pid, ok := claimOf(entry.Name())
+		if !ok || (pid != os.Getpid() && alive(pid)) {
+			continue
+		}
+
+		if err := os.Rename(filepath.Join(w.Inbox, ClaimedDir, entry.Name()), filepath.Join(w.Inbox, name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// claimOf returns the name of a claimed file and the pid of the process which
+// claimed it, from the `<name>.<pid>.<id>` name of its claim.
+func claimOf(claim string) (string, int, bool) {
+	rest, id, ok := cutLast(claim, ".")
+	if !ok || id == "" {
+		return "", 0, false
+	}
+
+	name, pidText, ok := cutLast(rest, ".")
+	if !ok || name == "" {
+		return "", 0, false
+	}
+
+	pid, err := strconv.Atoi(pidText)
+	if err != nil || pid <= 0 {
+		return "", 0, false
+	}
+
+	return name, pid, true
+}
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s string, sep string) (string, string, bool) {
+	index := strings.LastIndex(s, sep)
+	if index < 0 {
+		return s, "", false
+	}
+
+	return s[:index], s[index+len(sep):], true
+}
+
+// process calls the function with giving file claimed at path, retrying failed
+// calls, and moves the file based on the result.
+func (w *Worker) process(ctx context.Context, name string, path string) {
+	record := Record{File: name, Function: w.Function, Started: time.Now()}
+	defer func() {
+		record.Ended = time.Now()
+		record.Duration = record.Ended.Sub(record.Started).String()
+		w.log(record)
+	}()
+
+	attempts := w.Retry.Attempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for record.Attempts = 1; ; record.Attempts++ {
+		if err = w.attempt(ctx, name, path); err == nil {
+			record.Status = Succeeded
+			os.Remove(path)
+			return
+		}
+
+		if ctx.Err() != nil || record.Attempts >= attempts || !w.retries(err) {
+			break
+		}
+
+		timer := time.NewTimer(w.Retry.Delay(record.Attempts + 1))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	record.Error = err.Error()
+
+	if ctx.Err() != nil {
+		record.Status = Returned
+		if rerr := os.Rename(path, filepath.Join(w.Inbox, name)); rerr != nil {
+			record.Error = fmt.Sprintf("%s, and failed to return file into inbox: %s", err, rerr)
+		}
+
+		return
+	}
+
+	record.Status = Failed
+	if ferr := w.fail(name, path, err); ferr != nil {
+		record.Error = fmt.Sprintf("%s, and failed to move file into failed directory: %s", err, ferr)
+	}
+}
+
+// attempt calls the function once with giving file claimed at path, writing its
+// output into a temporary file of the outbox which is renamed once the call succeeds.
+func (w *Worker) attempt(ctx context.Context, name string, path string) error {
+	input, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer input.Close()
+
+	output, err := os.CreateTemp(w.Outbox, "."+name+".*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(output.Name())
+
+	err = w.Call(internals.WithInvocationID(ctx, internals.NewInvocationID()), input, output)
+	if cerr := output.Close(); err == nil {
+		err = cerr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(output.Name(), filepath.Join(w.Outbox, name))
+}
+
+// retries returns true/false if giving error should be retried, like the @retry annotation.
+func (w *Worker) retries(err error) bool {
+	if w.Retry.On == internals.RetryOnAny {
+		return true
+	}
+
+	return internals.NewErrorEnvelope(w.Function, err).Retryable
+}
+
+// fail moves giving file claimed at path into the failed directory, next to its
+// error envelope.
+func (w *Worker) fail(name string, path string, err error) error {
+	envelope, merr := json.Marshal(internals.NewErrorEnvelope(w.Function, err))
+	if merr != nil {
+		return merr
+	}
+
+	if err := os.WriteFile(filepath.Join(w.Failed, name+ErrorSuffix), append(envelope, '\n'), 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(path, filepath.Join(w.Failed, name))
+}
+
+func (w *Worker) log(record Record) {
+	if w.Log == nil {
+		return
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+
+	w.lm.Lock()
+	defer w.lm.Unlock()
+
+	w.Log.Write(append(data, '\n'))
+}
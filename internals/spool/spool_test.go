@@ -0,0 +1,289 @@
+package spool
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// unavailable is a retryable error, like those of services which are down for a moment.
+type unavailable struct{}
+
+func (unavailable) Error() string   { return "unavailable" }
+func (unavailable) Retryable() bool { return true }
+
+// spoolDirs returns a worker using directories within a temporary directory.
+func spoolDirs(t *testing.T) *Worker {
+	root := t.TempDir()
+	return &Worker{
+		Inbox:    filepath.Join(root, "inbox"),
+		Outbox:   filepath.Join(root, "outbox"),
+		Failed:   filepath.Join(root, "failed"),
+		Function: "Shout",
+		Poll:     10 * time.Millisecond,
+	}
+}
+
+func writeFile(t *testing.T, path string, data string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("reading %s failed: %s", filepath.Base(path), err)
+	}
+
+	return string(data)
+}
+
+// runUntil runs the worker until count records were logged, returning them by file.
+func runUntil(t *testing.T, w *Worker, count int) map[string]Record {
+	t.Helper()
+
+	var log safeBuffer
+	w.Log = &log
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	done := make(chan error)
+	go func() { done <- w.Run(ctx) }()
+
+	for ctx.Err() == nil && strings.Count(log.String(), "\n") < count {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run returned %v, before processing %d files", err, count)
+	}
+
+	records := map[string]Record{}
+	for _, line := range strings.Split(strings.TrimSpace(log.String()), "\n") {
+		var record Record
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid record %q: %s", line, err)
+		}
+
+		records[record.File] = record
+	}
+
+	return records
+}
+
+func TestWorker(t *testing.T) {
+	w := spoolDirs(t)
+	w.Concurrency = 2
+	w.Retry = internals.Retry{Attempts: 3, Initial: time.Millisecond}
+
+	var mu sync.Mutex
+	calls := map[string]int{}
+
+	w.Call = func(ctx context.Context, input io.Reader, output io.Writer) error {
+		data, _ := io.ReadAll(input)
+
+		mu.Lock()
+		calls[string(data)]++
+		attempt := calls[string(data)]
+		mu.Unlock()
+
+		switch string(data) {
+		case "flaky":
+			if attempt < 3 {
+				return unavailable{}
+			}
+		case "broken":
+			return errors.New("broken input")
+		}
+
+		_, err := io.WriteString(output, strings.ToUpper(string(data)))
+		return err
+	}
+
+	writeFile(t, filepath.Join(w.Inbox, "a.txt"), "hello")
+	writeFile(t, filepath.Join(w.Inbox, "b.txt"), "flaky")
+	writeFile(t, filepath.Join(w.Inbox, "c.txt"), "broken")
+	writeFile(t, filepath.Join(w.Inbox, ".d.txt"), "still being written")
+
+	records := runUntil(t, w, 3)
+
+	if r := records["a.txt"]; r.Status != Succeeded || r.Attempts != 1 {
+		t.Errorf("a.txt ended %s after %d attempts", r.Status, r.Attempts)
+	}
+
+	if r := records["b.txt"]; r.Status != Succeeded || r.Attempts != 3 {
+		t.Errorf("b.txt ended %s after %d attempts, expected to succeed on the third", r.Status, r.Attempts)
+	}
+
+	if r := records["c.txt"]; r.Status != Failed || r.Attempts != 1 || r.Error != "broken input" {
+		t.Errorf("c.txt ended %s after %d attempts with %q, expected to fail without retries", r.Status, r.Attempts, r.Error)
+	}
+
+	if out := readFile(t, filepath.Join(w.Outbox, "a.txt")); out != "HELLO" {
+		t.Errorf("output of a.txt is %q", out)
+	}
+
+	if out := readFile(t, filepath.Join(w.Outbox, "b.txt")); out != "FLAKY" {
+		t.Errorf("output of b.txt is %q", out)
+	}
+
+	if in := readFile(t, filepath.Join(w.Failed, "c.txt")); in != "broken" {
+		t.Errorf("failed c.txt holds %q", in)
+	}
+
+	var envelope internals.ErrorEnvelope
+	if err := json.Unmarshal([]byte(readFile(t, filepath.Join(w.Failed, "c.txt"+ErrorSuffix))), &envelope); err != nil || envelope.Function != "Shout" {
+		t.Errorf("error envelope of c.txt is %+v, %v", envelope, err)
+	}
+
+	if _, err := os.Stat(filepath.Join(w.Inbox, ".d.txt")); err != nil {
+		t.Errorf("file starting with a dot was claimed: %s", err)
+	}
+
+	if entries, _ := os.ReadDir(filepath.Join(w.Inbox, ClaimedDir)); len(entries) != 0 {
+		t.Errorf("claims were left behind: %v", entries)
+	}
+}
+
+func TestWorkerReturnsCancelledFiles(t *testing.T) {
+	w := spoolDirs(t)
+	started := make(chan struct{})
+
+	w.Call = func(ctx context.Context, input io.Reader, output io.Writer) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	writeFile(t, filepath.Join(w.Inbox, "slow.txt"), "slow")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- w.Run(ctx) }()
+
+	<-started
+	cancel()
+	<-done
+
+	if in := readFile(t, filepath.Join(w.Inbox, "slow.txt")); in != "slow" {
+		t.Errorf("cancelled file was not returned into the inbox")
+	}
+}
+
+func TestWorkerReclaimsFilesOfGoneWorkers(t *testing.T) {
+	w := spoolDirs(t)
+	w.Call = func(ctx context.Context, input io.Reader, output io.Writer) error {
+		_, err := io.Copy(output, input)
+		return err
+	}
+
+	// A process which ended stands in for a worker which crashed.
+	gone := exec.Command("true")
+	if err := gone.Run(); err != nil {
+		t.Skipf("no process to stand in for a crashed worker: %s", err)
+	}
+
+	claims := filepath.Join(w.Inbox, ClaimedDir)
+	writeFile(t, filepath.Join(claims, fmt.Sprintf("crashed.v1.txt.%d.0a1b2c", gone.Process.Pid)), "crashed")
+	writeFile(t, filepath.Join(claims, fmt.Sprintf("running.txt.%d.3d4e5f", os.Getppid())), "running")
+	writeFile(t, filepath.Join(claims, "unknown.txt"), "unknown")
+
+	records := runUntil(t, w, 1)
+
+	if r, ok := records["crashed.v1.txt"]; !ok || r.Status != Succeeded {
+		t.Errorf("claim of a crashed worker was not processed again, records: %+v", records)
+	}
+
+	if out := readFile(t, filepath.Join(w.Outbox, "crashed.v1.txt")); out != "crashed" {
+		t.Errorf("output of crashed.v1.txt is %q", out)
+	}
+
+	entries, _ := os.ReadDir(claims)
+
+	var left []string
+	for _, entry := range entries {
+		left = append(left, entry.Name())
+	}
+
+	if expected := fmt.Sprintf("[running.txt.%d.3d4e5f unknown.txt]", os.Getppid()); fmt.Sprint(left) != expected {
+		t.Errorf("claims left are %v, expected %s", left, expected)
+	}
+}
+
+func TestClaimsDoNotCollide(t *testing.T) {
+	w := spoolDirs(t)
+	if err := os.MkdirAll(filepath.Join(w.Inbox, ClaimedDir), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile(t, filepath.Join(w.Inbox, "report.csv"), "first")
+	first, ok := w.claim("report.csv")
+	if !ok {
+		t.Fatal("first claim failed")
+	}
+
+	writeFile(t, filepath.Join(w.Inbox, "report.csv"), "second")
+	second, ok := w.claim("report.csv")
+	if !ok {
+		t.Fatal("second claim failed")
+	}
+
+	if first == second || readFile(t, first) != "first" || readFile(t, second) != "second" {
+		t.Errorf("claims of files with the same name collided at %s and %s", first, second)
+	}
+
+	if _, ok := w.claim("report.csv"); ok {
+		t.Errorf("claim of a file which is gone succeeded")
+	}
+
+	if name, pid, ok := claimOf(filepath.Base(first)); !ok || name != "report.csv" || pid != os.Getpid() {
+		t.Errorf("claimOf(%q) returned %q, %d, %t", filepath.Base(first), name, pid, ok)
+	}
+
+	for _, claim := range []string{"report.csv", "report.abc.0a1b", ".12.0a1b", "report.-1.0a1b", "report.12."} {
+		if name, pid, ok := claimOf(claim); ok {
+			t.Errorf("claimOf(%q) returned %q, %d, expected no claim", claim, name, pid)
+		}
+	}
+}
+
+// safeBuffer is a bytes.Buffer shared by the worker's calls and the test.
+type safeBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *safeBuffer) Write(data []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(data)
+}
+
+func (b *safeBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
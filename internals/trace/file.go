@@ -0,0 +1,166 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// event is a complete event of the Chrome trace-event format.
+type event struct {
+	Name string                 `json:"name"`
+	Cat  string                 `json:"cat"`
+	Ph   string                 `json:"ph"`
+	TS   float64                `json:"ts"`
+	Dur  float64                `json:"dur"`
+	PID  int                    `json:"pid"`
+	TID  int                    `json:"tid"`
+	Args map[string]interface{} `json:"args,omitempty"`
+}
+
+// Reset empties the trace file at path, creating it if it does not exist.
+func Reset(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+// Write appends giving spans to the trace file at path in giving format, so the
+// spans of every process of an invocation can share a file.
+//
+// The json format writes a line for each span. The chrome format writes the
+// JSON Array Format of trace events, whose closing bracket is left out so later
+// processes can append to it, until Finish adds it.
+func Write(path string, format string, spans []Span) error {
+	if err := ValidFormat(format); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	var content bytes.Buffer
+	if format == FormatChrome && stat.Size() == 0 {
+		content.WriteString("[\n")
+	}
+
+	for _, span := range spans {
+		var data []byte
+		var err error
+
+		if format == FormatChrome {
+			data, err = json.Marshal(toEvent(span))
+		} else {
+			data, err = json.Marshal(span)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		content.Write(data)
+
+		if format == FormatChrome {
+			content.WriteByte(',')
+		}
+
+		content.WriteByte('\n')
+	}
+
+	_, err = file.Write(content.Bytes())
+	return err
+}
+
+// Finish closes the array of trace events of a chrome format file at path, which
+// should only be done by the process which shared it once every process wrote its
+// spans. Files of the json format are left as they are.
+func Finish(path string, format string) error {
+	if format != FormatChrome {
+		return nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	content = bytes.TrimRight(content, ",\n")
+	if len(content) == 0 {
+		content = []byte("[")
+	}
+
+	return os.WriteFile(path, append(content, "\n]\n"...), 0600)
+}
+
+// toEvent returns the Chrome trace event of giving span, with times in microseconds.
+func toEvent(span Span) event {
+	args := make(map[string]interface{}, len(span.Attrs)+1)
+	for key, value := range span.Attrs {
+		args[key] = value
+	}
+
+	if span.Error != "" {
+		args["error"] = span.Error
+	}
+
+	return event{
+		Name: span.Name,
+		Cat:  "shogun",
+		Ph:   "X",
+		TS:   float64(span.Start.UnixNano()) / 1e3,
+		Dur:  float64(span.Duration) / 1e3,
+		PID:  span.PID,
+		TID:  1,
+		Args: args,
+	}
+}
+
+// consts of environment variables with which processes share a trace file.
+const (
+	PathEnv   = "SHOGUN_TRACE"
+	FormatEnv = "SHOGUN_TRACE_FORMAT"
+)
+
+// Share empties the trace file at path, unless a parent process already shared
+// it, then shares it with child processes through environment variables so their
+// spans are appended to it. It returns true if this process emptied the file, and
+// so should Finish it.
+func Share(path string, format string) (bool, error) {
+	if err := ValidFormat(format); err != nil {
+		return false, err
+	}
+
+	var owner bool
+	if os.Getenv(PathEnv) != path {
+		if err := Reset(path); err != nil {
+			return false, err
+		}
+
+		owner = true
+	}
+
+	os.Setenv(PathEnv, path)
+	os.Setenv(FormatEnv, format)
+	return owner, nil
+}
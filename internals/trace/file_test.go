@@ -0,0 +1,147 @@
+package trace
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func spansOf(pid int, names ...string) []Span {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var spans []Span
+	for index, name := range names {
+		spans = append(spans, Span{
+			ID:       index + 1,
+			PID:      pid,
+			Name:     name,
+			Start:    start,
+			Duration: 1500 * time.Microsecond,
+			Attrs:    map[string]interface{}{"index": index},
+		})
+	}
+
+	return spans
+}
+
+// TestSharedChromeTrace writes the spans of a parent and a child process into
+// one shared file, as a binary calling another does.
+func TestSharedChromeTrace(t *testing.T) {
+	t.Setenv(PathEnv, "")
+	t.Setenv(FormatEnv, "")
+
+	path := filepath.Join(t.TempDir(), "traces", "run.json")
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	// A trace of an earlier run is emptied by the process which shares the file.
+	if err := os.WriteFile(path, []byte(`[{"name":"stale"}]`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	owner, err := Share(path, FormatChrome)
+	if err != nil || !owner {
+		t.Fatalf("first Share returned %t, %v, expected it to own the file", owner, err)
+	}
+
+	if os.Getenv(PathEnv) != path || os.Getenv(FormatEnv) != FormatChrome {
+		t.Fatalf("file was not shared through the environment")
+	}
+
+	if owner, err := Share(path, FormatChrome); err != nil || owner {
+		t.Fatalf("Share of a child process returned %t, %v", owner, err)
+	}
+
+	if err := Write(path, FormatChrome, spansOf(2, "child")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Write(path, FormatChrome, spansOf(1, "parent", "call")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Finish(path, FormatChrome); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var events []event
+	if err := json.Unmarshal(data, &events); err != nil {
+		t.Fatalf("finished trace is not a JSON array: %s\n%s", err, data)
+	}
+
+	if len(events) != 3 || events[0].Name != "child" || events[0].PID != 2 || events[2].Name != "call" {
+		t.Fatalf("trace holds %+v", events)
+	}
+
+	call := events[2]
+	if call.Ph != "X" || call.Dur != 1500 || call.TS != 1704164645e6 || call.Args["index"] != float64(1) {
+		t.Errorf("call event is %+v", call)
+	}
+}
+
+func TestFinishEmptyChromeTrace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.json")
+	if err := Reset(path); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Finish(path, FormatChrome); err != nil {
+		t.Fatal(err)
+	}
+
+	if data, _ := os.ReadFile(path); string(data) != "[\n]\n" {
+		t.Errorf("trace without spans is %q", data)
+	}
+}
+
+func TestJSONTrace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.jsonl")
+
+	failed := spansOf(1, "call")
+	failed[0].Error = "broken"
+
+	for _, spans := range [][]Span{spansOf(1, "input"), failed} {
+		if err := Write(path, FormatJSON, spans); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := Finish(path, FormatJSON); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+
+	var spans []Span
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var span Span
+		if err := json.Unmarshal(scanner.Bytes(), &span); err != nil {
+			t.Fatalf("invalid line %q: %s", scanner.Text(), err)
+		}
+
+		spans = append(spans, span)
+	}
+
+	if len(spans) != 2 || spans[0].Name != "input" || spans[1].Error != "broken" || spans[1].Duration != 1500*time.Microsecond {
+		t.Errorf("trace holds %+v", spans)
+	}
+
+	if err := Write(path, "xml", nil); err == nil {
+		t.Error("Write accepted an unknown format")
+	}
+}
@@ -0,0 +1,161 @@
+// Package trace records timing spans of function invocations, which are written
+// as JSON lines or as Chrome trace-event JSON for `chrome://tracing` and Perfetto.
+//
+// Functions add their own spans through the context they are called with:
+//
+//	ctx, span := trace.Start(ctx, "query")
+//	defer span.End()
+//
+// Spans are only recorded when the binary runs with `--trace`, otherwise Start
+// returns a nil span whose methods do nothing.
+package trace
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// consts of trace file formats.
+const (
+	FormatJSON   = "json"
+	FormatChrome = "chrome"
+)
+
+type contextKey string
+
+// consts of context keys.
+const (
+	tracerKey contextKey = "shogun:tracer"
+	spanKey   contextKey = "shogun:span"
+)
+
+// Span is a timed part of an invocation.
+type Span struct {
+	ID       int                    `json:"id"`
+	Parent   int                    `json:"parent,omitempty"`
+	PID      int                    `json:"pid"`
+	Name     string                 `json:"name"`
+	Start    time.Time              `json:"start"`
+	Duration time.Duration          `json:"duration"`
+	Attrs    map[string]interface{} `json:"attrs,omitempty"`
+	Error    string                 `json:"error,omitempty"`
+
+	tracer *Tracer
+}
+
+// Set adds an attribute with giving key and value to the span.
+func (s *Span) Set(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+
+	if s.Attrs == nil {
+		s.Attrs = make(map[string]interface{})
+	}
+
+	s.Attrs[key] = value
+}
+
+// Fail records giving error on the span if it is not nil.
+func (s *Span) Fail(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+
+	s.Error = err.Error()
+}
+
+// End ends the span, recording its duration. Only the first call has effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+
+	if s.Duration != 0 {
+		return
+	}
+
+	if s.Duration = time.Since(s.Start); s.Duration == 0 {
+		s.Duration = time.Nanosecond
+	}
+}
+
+// Tracer collects the spans of a process.
+type Tracer struct {
+	mu    sync.Mutex
+	pid   int
+	spans []*Span
+}
+
+// New returns a new Tracer for spans of the process with giving pid.
+func New(pid int) *Tracer {
+	return &Tracer{pid: pid}
+}
+
+// Spans returns a copy of all ended spans in the order they were started.
+func (t *Tracer) Spans() []Span {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	spans := make([]Span, 0, len(t.spans))
+	for _, span := range t.spans {
+		if span.Duration != 0 {
+			spans = append(spans, *span)
+		}
+	}
+
+	return spans
+}
+
+// With returns a new context which carries giving tracer, making Start record spans.
+func With(ctx context.Context, t *Tracer) context.Context {
+	return context.WithValue(ctx, tracerKey, t)
+}
+
+// Start returns a new span with giving name as a child of the span within ctx,
+// and a context which carries it. It returns a nil span if ctx carries no tracer.
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	tracer, ok := ctx.Value(tracerKey).(*Tracer)
+	if !ok {
+		return ctx, nil
+	}
+
+	span := &Span{
+		Name:   name,
+		PID:    tracer.pid,
+		Start:  time.Now(),
+		tracer: tracer,
+	}
+
+	if parent, ok := ctx.Value(spanKey).(*Span); ok {
+		span.Parent = parent.ID
+	}
+
+	tracer.mu.Lock()
+	span.ID = len(tracer.spans) + 1
+	tracer.spans = append(tracer.spans, span)
+	tracer.mu.Unlock()
+
+	return context.WithValue(ctx, spanKey, span), span
+}
+
+// ValidFormat returns an error if giving format is not a known format.
+func ValidFormat(format string) error {
+	switch format {
+	case FormatJSON, FormatChrome:
+		return nil
+	}
+
+	return fmt.Errorf("Unknown trace format %q, expected %q or %q", format, FormatJSON, FormatChrome)
+}
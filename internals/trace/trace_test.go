@@ -0,0 +1,114 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSpans(t *testing.T) {
+	tracer := New(42)
+	ctx := With(context.Background(), tracer)
+
+	ctx, call := Start(ctx, "call")
+	call.Set("function", "Greet")
+
+	inputCtx, input := Start(ctx, "input")
+	input.Fail(nil)
+	input.End()
+
+	_, query := Start(inputCtx, "query")
+	query.Fail(errors.New("no rows"))
+	query.End()
+	query.End()
+
+	_, open := Start(ctx, "open")
+
+	call.End()
+
+	spans := tracer.Spans()
+
+	type summary struct {
+		ID, Parent int
+		Name       string
+		Error      string
+	}
+
+	var got []summary
+	for _, span := range spans {
+		got = append(got, summary{ID: span.ID, Parent: span.Parent, Name: span.Name, Error: span.Error})
+
+		if span.PID != 42 || span.Duration <= 0 {
+			t.Errorf("span %q has pid %d and duration %s", span.Name, span.PID, span.Duration)
+		}
+	}
+
+	expected := []summary{
+		{ID: 1, Name: "call"},
+		{ID: 2, Parent: 1, Name: "input"},
+		{ID: 3, Parent: 2, Name: "query", Error: "no rows"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("spans %+v, expected %+v without the span which did not end", got, expected)
+	}
+
+	if spans[0].Attrs["function"] != "Greet" {
+		t.Errorf("call span has attributes %v", spans[0].Attrs)
+	}
+
+	open.End()
+	if len(tracer.Spans()) != 4 {
+		t.Error("span was not recorded once it ended")
+	}
+}
+
+func TestSpansWithoutTracer(t *testing.T) {
+	ctx, span := Start(context.Background(), "call")
+	if span != nil || ctx != context.Background() {
+		t.Fatalf("Start without a tracer returned span %+v", span)
+	}
+
+	// Methods of nil spans do nothing, so functions need not check for them.
+	span.Set("key", "value")
+	span.Fail(errors.New("failed"))
+	span.End()
+}
+
+func TestConcurrentSpans(t *testing.T) {
+	tracer := New(1)
+	ctx, parent := Start(With(context.Background(), tracer), "batch")
+
+	var wg sync.WaitGroup
+	for index := 0; index < 20; index++ {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+
+			_, span := Start(ctx, "item")
+			span.Set("index", index)
+			span.End()
+		}(index)
+	}
+
+	wg.Wait()
+	parent.End()
+
+	seen := map[int]bool{}
+	for _, span := range tracer.Spans() {
+		if seen[span.ID] {
+			t.Errorf("span id %d was given twice", span.ID)
+		}
+
+		seen[span.ID] = true
+		if span.Name == "item" && span.Parent != parent.ID {
+			t.Errorf("item span has parent %d", span.Parent)
+		}
+	}
+
+	if len(seen) != 21 {
+		t.Errorf("%d spans recorded, expected 21", len(seen))
+	}
+}
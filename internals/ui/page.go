@@ -0,0 +1,264 @@
+package ui
+
+// page is the web page served by the ui, which builds its forms from `GET /api/functions`.
+const page = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>shogun</title>
+<style>
+  * { box-sizing: border-box; }
+  body { margin: 0; font: 14px/1.5 -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; color: #1f2328; display: flex; height: 100vh; }
+  nav { width: 260px; border-right: 1px solid #d0d7de; overflow-y: auto; background: #f6f8fa; flex-shrink: 0; }
+  nav h1 { font-size: 16px; margin: 0; padding: 14px 16px; border-bottom: 1px solid #d0d7de; }
+  nav h2 { font-size: 11px; text-transform: uppercase; letter-spacing: .05em; color: #656d76; margin: 14px 16px 4px; }
+  nav a { display: block; padding: 4px 16px; color: inherit; text-decoration: none; cursor: pointer; }
+  nav a:hover { background: #eaeef2; }
+  nav a.active { background: #ddf4ff; font-weight: 600; }
+  nav small { display: block; color: #656d76; white-space: nowrap; overflow: hidden; text-overflow: ellipsis; font-weight: normal; }
+  main { flex: 1; overflow-y: auto; padding: 20px 28px; }
+  h2.title { margin: 0 0 4px; }
+  pre { background: #f6f8fa; border: 1px solid #d0d7de; border-radius: 6px; padding: 10px; overflow-x: auto; white-space: pre-wrap; margin: 6px 0 14px; }
+  pre.desc { background: none; border: none; padding: 0; color: #424a53; font-family: inherit; }
+  fieldset { border: 1px solid #d0d7de; border-radius: 6px; margin: 0 0 14px; padding: 8px 14px 12px; }
+  legend { font-weight: 600; padding: 0 4px; }
+  label { display: block; margin: 8px 0 2px; font-weight: 600; }
+  label span { font-weight: normal; color: #656d76; margin-left: 6px; }
+  input[type=text], input[type=number], textarea { width: 100%; padding: 5px 8px; border: 1px solid #d0d7de; border-radius: 6px; font: 13px ui-monospace, Menlo, Consolas, monospace; }
+  textarea { min-height: 90px; }
+  button { padding: 6px 16px; border-radius: 6px; border: 1px solid #1f883d; background: #1f883d; color: #fff; font-weight: 600; cursor: pointer; }
+  button:disabled { opacity: .6; cursor: default; }
+  .error { border-color: #ff818266; background: #ffebe9; color: #82071e; }
+  .muted { color: #656d76; }
+  table { border-collapse: collapse; width: 100%; font-size: 13px; }
+  th, td { text-align: left; padding: 5px 8px; border-bottom: 1px solid #d0d7de; }
+  tr.run { cursor: pointer; }
+  tr.run:hover { background: #f6f8fa; }
+  .failed { color: #cf222e; }
+</style>
+</head>
+<body>
+<nav><h1>shogun</h1><div id="functions"></div></nav>
+<main>
+  <div id="function"><p class="muted">Pick a function to call it.</p></div>
+  <h3>Recent runs</h3>
+  <table><thead><tr><th>Time</th><th>Function</th><th>Duration</th><th>Exit</th><th>ID</th></tr></thead><tbody id="runs"></tbody></table>
+</main>
+<script>
+var functions = [];
+var current = null;
+
+function el(tag, attrs, children) {
+  var node = document.createElement(tag);
+  Object.keys(attrs || {}).forEach(function (key) {
+    if (key === "text") { node.textContent = attrs[key]; } else { node.setAttribute(key, attrs[key]); }
+  });
+  (children || []).forEach(function (child) { if (child) { node.appendChild(child); } });
+  return node;
+}
+
+function duration(ns) {
+  if (ns < 1e6) { return (ns / 1e3).toFixed(0) + "µs"; }
+  if (ns < 1e9) { return (ns / 1e6).toFixed(1) + "ms"; }
+  return (ns / 1e9).toFixed(2) + "s";
+}
+
+function load() {
+  fetch("api/functions").then(function (res) { return res.json(); }).then(function (list) {
+    functions = list;
+    var nav = document.getElementById("functions");
+    var binary = null;
+    list.forEach(function (fn) {
+      if (fn.binary !== binary) {
+        binary = fn.binary;
+        nav.appendChild(el("h2", {text: binary}));
+      }
+      var link = el("a", {id: "fn-" + fn.id}, [document.createTextNode(fn.name), el("small", {text: (fn.desc || "").split("\n")[0]})]);
+      link.onclick = function () { show(fn); location.hash = fn.id; };
+      nav.appendChild(link);
+    });
+    var selected = list.filter(function (fn) { return fn.id === location.hash.slice(1); })[0];
+    if (selected) { show(selected); }
+  });
+  runs();
+}
+
+function runs() {
+  fetch("api/runs").then(function (res) { return res.json(); }).then(function (records) {
+    var body = document.getElementById("runs");
+    body.innerHTML = "";
+    records.forEach(function (rec) {
+      var row = el("tr", {"class": "run" + (rec.exit_code ? " failed" : "")}, [
+        el("td", {text: new Date(rec.time).toLocaleString()}),
+        el("td", {text: rec.args.join(" ")}),
+        el("td", {text: duration(rec.duration)}),
+        el("td", {text: String(rec.exit_code)}),
+        el("td", {text: rec.id})
+      ]);
+      row.onclick = function () { replay(rec); };
+      body.appendChild(row);
+    });
+  });
+}
+
+function replay(rec) {
+  var details = document.getElementById("details");
+  if (!details) { return; }
+  details.innerHTML = "";
+  details.appendChild(el("h3", {text: "Run " + rec.id}));
+  details.appendChild(el("label", {text: "Input"}));
+  details.appendChild(el("pre", {text: atob(rec.input || "") || "(none)"}));
+  details.appendChild(el("label", {text: "Output"}));
+  details.appendChild(el("pre", {text: atob(rec.output || "") || "(none)"}));
+  if (rec.error) { details.appendChild(el("pre", {"class": "error", text: JSON.stringify(rec.error, null, 2)})); }
+}
+
+function field(name, hint, input) {
+  return el("div", {}, [el("label", {text: name}, [el("span", {text: hint})]), input]);
+}
+
+function show(fn) {
+  current = fn;
+  Array.prototype.forEach.call(document.querySelectorAll("nav a"), function (a) { a.className = ""; });
+  document.getElementById("fn-" + fn.id).className = "active";
+
+  var form = el("form", {});
+  var view = document.getElementById("function");
+  view.innerHTML = "";
+  view.appendChild(el("h2", {"class": "title", text: fn.binary + " " + fn.ns}));
+  view.appendChild(el("pre", {"class": "desc", text: fn.desc || ""}));
+
+  if (fn.flags.length) {
+    var flags = el("fieldset", {}, [el("legend", {text: "Flags"})]);
+    fn.flags.forEach(function (flag) {
+      var input = flag.type === "Bool" || flag.type === "TBool"
+        ? el("input", {type: "checkbox", name: "flag:" + flag.name})
+        : el("input", {type: "text", name: "flag:" + flag.name, placeholder: flag.type});
+      if (flag.type === "TBool") { input.checked = true; }
+      flags.appendChild(field(flag.name, flag.desc, input));
+    });
+    form.appendChild(flags);
+  }
+
+  var input = el("fieldset", {}, [el("legend", {text: "Input" + (fn.input.type ? " (" + fn.input.type + ")" : "")})]);
+  switch (fn.input.kind) {
+  case "struct":
+    fn.input.fields.forEach(function (f) {
+      var node = f.type === "boolean" ? el("input", {type: "checkbox"})
+        : f.type === "json" ? el("textarea", {placeholder: "JSON"})
+        : el("input", {type: f.type === "number" ? "number" : "text", step: "any"});
+      node.setAttribute("name", "field:" + f.name);
+      input.appendChild(field(f.name, f.go, node));
+    });
+    break;
+  case "json":
+    input.appendChild(el("textarea", {name: "input", placeholder: "JSON"}));
+    break;
+  case "text":
+    input.appendChild(el("textarea", {name: "input"}));
+    break;
+  case "args":
+    input.appendChild(field("Arguments", "one per line", el("textarea", {name: "args"})));
+    break;
+  default:
+    input.appendChild(el("p", {"class": "muted", text: "Takes no input."}));
+  }
+  form.appendChild(input);
+
+  var button = el("button", {type: "submit", text: "Run"});
+  form.appendChild(button);
+  form.onsubmit = function (event) { event.preventDefault(); call(fn, form, button); };
+  view.appendChild(form);
+
+  view.appendChild(el("h3", {text: "Output"}));
+  view.appendChild(el("pre", {id: "output", text: ""}));
+  view.appendChild(el("pre", {id: "error", "class": "error", style: "display: none"}));
+  view.appendChild(el("p", {id: "status", "class": "muted"}));
+  view.appendChild(el("div", {id: "details"}));
+}
+
+function collect(fn, form) {
+  var run = {input: "", args: [], flags: {}};
+  var data = {};
+  Array.prototype.forEach.call(form.elements, function (node) {
+    var name = node.getAttribute("name") || "";
+    if (name.indexOf("flag:") === 0) {
+      if (node.type === "checkbox") { run.flags[name.slice(5)] = String(node.checked); }
+      else if (node.value !== "") { run.flags[name.slice(5)] = node.value; }
+    } else if (name.indexOf("field:") === 0) {
+      var key = name.slice(6);
+      if (node.type === "checkbox") { data[key] = node.checked; }
+      else if (node.value === "") { return; }
+      else if (node.type === "number") { data[key] = Number(node.value); }
+      else if (node.tagName === "TEXTAREA") { data[key] = JSON.parse(node.value); }
+      else { data[key] = node.value; }
+    } else if (name === "input") {
+      run.input = node.value;
+    } else if (name === "args") {
+      run.args = node.value.split("\n").filter(function (line) { return line !== ""; });
+    }
+  });
+  if (fn.input.kind === "struct") { run.input = JSON.stringify(data); }
+  return run;
+}
+
+function call(fn, form, button) {
+  var output = document.getElementById("output");
+  var failure = document.getElementById("error");
+  var status = document.getElementById("status");
+  output.textContent = "";
+  failure.style.display = "none";
+
+  var run;
+  try { run = collect(fn, form); } catch (err) {
+    failure.textContent = "Invalid JSON field: " + err.message;
+    failure.style.display = "";
+    return;
+  }
+
+  button.disabled = true;
+  status.textContent = "Running…";
+
+  fetch("api/run/" + fn.id, {method: "POST", headers: {"Content-Type": "application/json"}, body: JSON.stringify(run)}).then(function (res) {
+    if (!res.ok) { return res.text().then(function (text) { throw new Error(text); }); }
+    var reader = res.body.getReader();
+    var decoder = new TextDecoder();
+    var buffer = "";
+    function next() {
+      return reader.read().then(function (chunk) {
+        buffer += decoder.decode(chunk.value || new Uint8Array(), {stream: !chunk.done});
+        var lines = buffer.split("\n");
+        buffer = lines.pop();
+        lines.forEach(function (line) { if (line) { handle(JSON.parse(line)); } });
+        if (!chunk.done) { return next(); }
+      });
+    }
+    return next();
+  }).catch(function (err) {
+    failure.textContent = err.message;
+    failure.style.display = "";
+  }).then(function () {
+    button.disabled = false;
+    runs();
+  });
+
+  function handle(event) {
+    switch (event.event) {
+    case "start": status.textContent = "Running " + event.id + "…"; break;
+    case "output": output.textContent += event.data; break;
+    case "warning": status.textContent = event.data; break;
+    case "error":
+      failure.textContent = JSON.stringify(event.error, null, 2);
+      failure.style.display = "";
+      break;
+    case "done": status.textContent = "Finished in " + duration(event.duration || 0); break;
+    }
+  }
+}
+
+load();
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// consts of input kinds.
+const (
+	InputNone   = "none"
+	InputText   = "text"
+	InputJSON   = "json"
+	InputStruct = "struct"
+	InputArgs   = "args"
+)
+
+// consts of field types.
+const (
+	FieldString  = "string"
+	FieldNumber  = "number"
+	FieldBoolean = "boolean"
+	FieldJSON    = "json"
+)
+
+var (
+	contextType     = reflect.TypeOf((*context.Context)(nil)).Elem()
+	writeCloserType = reflect.TypeOf((*io.WriteCloser)(nil)).Elem()
+)
+
+// Field defines a field of a struct input, from which a form field is built.
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Go   string `json:"go"`
+}
+
+// Input describes what a function reads from its input, from which its form is built.
+type Input struct {
+	Kind   string  `json:"kind"`
+	Type   string  `json:"type,omitempty"`
+	Fields []Field `json:"fields,omitempty"`
+}
+
+// InputOf returns the Input of giving function, where the fields of struct inputs
+// are those encoding/json decodes.
+func InputOf(fn internals.ShogunFunc) Input {
+	switch fn.Type {
+	case internals.WithStringSliceArgument, internals.WithStringSliceArgumentAndWriteCloserArgument:
+		return Input{Kind: InputArgs}
+	case internals.WithStringArgument, internals.WithStringArgumentAndWriteCloserArgument,
+		internals.WithReaderArgument, internals.WithReaderAndWriteCloserArgument:
+		return Input{Kind: InputText}
+	case internals.WithMapArgument, internals.WithMapAndWriteCloserArgument,
+		internals.WithImportedObjectArgument, internals.WithImportedAndWriteCloserArgument:
+		input := Input{Kind: InputJSON}
+		if arg := inputArg(fn.Function); arg != nil {
+			input.Type = arg.String()
+		}
+
+		return input
+	case internals.WithStructArgument, internals.WithStructAndWriteCloserArgument:
+		arg := inputArg(fn.Function)
+		if arg == nil {
+			return Input{Kind: InputJSON}
+		}
+
+		return Input{Kind: InputStruct, Type: arg.String(), Fields: fieldsOf(arg)}
+	}
+
+	return Input{Kind: InputNone}
+}
+
+// inputArg returns the type of the parameter a function decodes its input into,
+// which is its last one before a trailing io.WriteCloser.
+func inputArg(function interface{}) reflect.Type {
+	tm := reflect.TypeOf(function)
+	if tm == nil || tm.Kind() != reflect.Func {
+		return nil
+	}
+
+	last := tm.NumIn() - 1
+	if last >= 0 && tm.In(last) == writeCloserType {
+		last--
+	}
+
+	if last < 0 || tm.In(last).Implements(contextType) {
+		return nil
+	}
+
+	return tm.In(last)
+}
+
+// fieldsOf returns the fields of a struct type as encoding/json sees them, with
+// those of embedded structs promoted.
+func fieldsOf(tm reflect.Type) []Field {
+	for tm.Kind() == reflect.Ptr {
+		tm = tm.Elem()
+	}
+
+	if tm.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []Field
+	for index := 0; index < tm.NumField(); index++ {
+		field := tm.Field(index)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+
+		if field.Anonymous && name == "" {
+			fields = append(fields, fieldsOf(field.Type)...)
+			continue
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields = append(fields, Field{Name: name, Type: fieldType(field.Type), Go: field.Type.String()})
+	}
+
+	return fields
+}
+
+// fieldType returns the type of form field used for values of giving type.
+func fieldType(tm reflect.Type) string {
+	for tm.Kind() == reflect.Ptr {
+		tm = tm.Elem()
+	}
+
+	switch tm.Kind() {
+	case reflect.String:
+		return FieldString
+	case reflect.Bool:
+		return FieldBoolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return FieldNumber
+	}
+
+	return FieldJSON
+}
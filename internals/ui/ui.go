@@ -0,0 +1,358 @@
+// Package ui serves a local web page listing the functions of a generated binary,
+// with forms built from their flags and inputs to call them and a view of recent runs.
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+	"github.com/influx6/shogun/internals/history"
+	"github.com/influx6/shogun/internals/serve"
+)
+
+// RecentRuns sets how many runs are returned by `GET /api/runs`.
+const RecentRuns = 50
+
+// Event is written as a JSON line into the response of a run for each change of it.
+type Event struct {
+	Event    string                   `json:"event"`
+	ID       string                   `json:"id,omitempty"`
+	Data     string                   `json:"data,omitempty"`
+	Error    *internals.ErrorEnvelope `json:"error,omitempty"`
+	Duration time.Duration            `json:"duration,omitempty"`
+}
+
+// Run contains what the page submits to call a function.
+type Run struct {
+	Input string            `json:"input"`
+	Args  []string          `json:"args"`
+	Flags map[string]string `json:"flags"`
+}
+
+// Function describes a function to the page.
+type Function struct {
+	ID      string           `json:"id"`
+	Name    string           `json:"name"`
+	NS      string           `json:"ns"`
+	Binary  string           `json:"binary"`
+	Desc    string           `json:"desc"`
+	Source  string           `json:"source"`
+	Flags   []Flag           `json:"flags"`
+	Input   Input            `json:"input"`
+	Timeout time.Duration    `json:"timeout,omitempty"`
+	Retry   *internals.Retry `json:"retry,omitempty"`
+}
+
+// Flag describes a flag of a function to the page.
+type Flag struct {
+	Name string `json:"name"`
+	Desc string `json:"desc"`
+	Type string `json:"type"`
+}
+
+// Server serves the page and the API it uses.
+type Server struct {
+	Binary    string
+	Functions []internals.ShogunFunc
+	Call      serve.Caller
+
+	// Timeout, RecordLimit and RecordInput are recorded with every run into the history.
+	Timeout     time.Duration
+	RecordLimit int
+	RecordInput bool
+
+	// MaxBody limits the bytes read from request bodies if above zero.
+	MaxBody int64
+}
+
+// ID returns the ID of giving function within the API, like `demo/greet`.
+func ID(fn internals.ShogunFunc) string {
+	return fn.Binary + "/" + fn.NS
+}
+
+// Handler returns the http.Handler serving the page at `GET /`, the functions at
+// `GET /api/functions`, recent runs at `GET /api/runs` and calls at `POST /api/run/<id>`.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		io.WriteString(w, page)
+	})
+
+	mux.HandleFunc("/api/functions", s.functions)
+	mux.HandleFunc("/api/runs", s.runs)
+	mux.HandleFunc("/api/run/", s.run)
+
+	return sameOrigin(mux)
+}
+
+// sameOrigin rejects requests made by pages of other origins, so other sites
+// opened in the same browser can not call functions. Requests for hosts other
+// than loopback addresses are rejected too, as pages of other sites reach the
+// server under their own host names by rebinding them to it.
+func sameOrigin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !loopback(r.Host) {
+			http.Error(w, "forbidden host", http.StatusForbidden)
+			return
+		}
+
+		if origin := r.Header.Get("Origin"); origin != "" {
+			parsed, err := url.Parse(origin)
+			if err != nil || parsed.Host != r.Host {
+				http.Error(w, "forbidden origin", http.StatusForbidden)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// loopback returns true/false if giving host, with or without a port, is localhost
+// or a loopback address.
+func loopback(host string) bool {
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		host = name
+	}
+
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(strings.TrimSuffix(strings.TrimPrefix(host, "["), "]"))
+	return ip != nil && ip.IsLoopback()
+}
+
+func (s *Server) functions(w http.ResponseWriter, r *http.Request) {
+	list := make([]Function, 0, len(s.Functions))
+	for _, fn := range s.Functions {
+		item := Function{
+			ID:      ID(fn),
+			Name:    fn.Name,
+			NS:      fn.NS,
+			Binary:  fn.Binary,
+			Desc:    fn.Doc(),
+			Source:  fn.Source,
+			Input:   InputOf(fn),
+			Timeout: fn.Timeout,
+			Retry:   fn.Retry,
+			Flags:   []Flag{},
+		}
+
+		for _, flag := range fn.Flags {
+			item.Flags = append(item.Flags, Flag{Name: flag.Name, Desc: flag.Desc, Type: flag.Type.String()})
+		}
+
+		list = append(list, item)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		if (list[i].Binary == s.Binary) != (list[j].Binary == s.Binary) {
+			return list[i].Binary == s.Binary
+		}
+
+		return list[i].Binary < list[j].Binary
+	})
+
+	writeJSON(w, list)
+}
+
+func (s *Server) runs(w http.ResponseWriter, r *http.Request) {
+	path, err := history.Path()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	records, err := history.Read(path, history.Filter{Binary: s.Binary, Limit: RecentRuns})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Newest runs come first.
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+
+	if records == nil {
+		records = []history.Record{}
+	}
+
+	writeJSON(w, records)
+}
+
+// run calls a function, streaming its output as events while recording it into the history.
+func (s *Server) run(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	fn, ok := s.find(strings.TrimPrefix(r.URL.Path, "/api/run/"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	body := io.Reader(r.Body)
+	if s.MaxBody > 0 {
+		body = http.MaxBytesReader(w, r.Body, s.MaxBody)
+	}
+
+	var run Run
+	if err := json.NewDecoder(body).Decode(&run); err != nil {
+		status := http.StatusBadRequest
+
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+
+		http.Error(w, "invalid run: "+err.Error(), status)
+		return
+	}
+
+	var flags []string
+	for _, flag := range fn.Flags {
+		if value, ok := run.Flags[flag.Name]; ok && value != "" {
+			flags = append(flags, flag.Name+"="+value)
+		}
+	}
+
+	session := history.Begin(s.Binary, fn.Name, commandOf(s.Binary, fn, run.Args, flags), s.Timeout, s.RecordLimit, s.RecordInput)
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	events := &eventWriter{w: w}
+	events.write(Event{Event: "start", ID: session.ID()})
+
+	started := time.Now()
+	ctx := internals.WithInvocationID(r.Context(), session.ID())
+	input := session.Input(strings.NewReader(run.Input))
+	output := session.Output(events)
+
+	err := s.Call(ctx, fn, run.Args, flags, input, nopCloser{Writer: output})
+
+	var envelope *internals.ErrorEnvelope
+	exitCode := 0
+	if err != nil {
+		failure := internals.NewErrorEnvelope(fn.Name, err)
+		envelope, exitCode = &failure, failure.ExitCode
+	}
+
+	// Runs are recorded even if the page went away, so they show up in its history.
+	if recordErr := session.End(exitCode, envelope); recordErr != nil {
+		events.write(Event{Event: "warning", Data: "Failed to record run: " + recordErr.Error()})
+	}
+
+	if envelope != nil {
+		events.write(Event{Event: "error", Error: envelope})
+	}
+
+	events.write(Event{Event: "done", Duration: time.Since(started)})
+}
+
+// find returns the function with giving ID.
+func (s *Server) find(id string) (internals.ShogunFunc, bool) {
+	for _, fn := range s.Functions {
+		if ID(fn) == id {
+			return fn, true
+		}
+	}
+
+	return internals.ShogunFunc{}, false
+}
+
+// commandOf returns the arguments which call giving function from the command
+// line of binary, so runs are replayed by `shogun replay`.
+func commandOf(binary string, fn internals.ShogunFunc, args []string, flags []string) []string {
+	command := []string{fn.NS}
+	if fn.Binary != binary {
+		command = []string{fn.Binary, fn.NS}
+	}
+
+	for _, flag := range flags {
+		command = append(command, "--"+flag)
+	}
+
+	return append(command, args...)
+}
+
+// eventWriter writes output as events, flushing each so the page shows it as it's written.
+type eventWriter struct {
+	mu sync.Mutex
+	w  http.ResponseWriter
+}
+
+// Write implements the io.Writer interface.
+func (e *eventWriter) Write(data []byte) (int, error) {
+	if err := e.write(Event{Event: "output", Data: string(data)}); err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
+
+func (e *eventWriter) write(event Event) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var line bytes.Buffer
+	if err := json.NewEncoder(&line).Encode(event); err != nil {
+		return err
+	}
+
+	if _, err := e.w.Write(line.Bytes()); err != nil {
+		return err
+	}
+
+	if flusher, ok := e.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+// Close does nothing.
+func (nopCloser) Close() error {
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(value)
+}
+
+// URL returns the URL of the page served at giving address.
+func URL(addr string) string {
+	host := addr
+	if strings.HasPrefix(host, ":") {
+		host = "localhost" + host
+	}
+
+	return "http://" + host
+}
@@ -0,0 +1,221 @@
+package ui
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/influx6/shogun/internals"
+	"github.com/influx6/shogun/internals/history"
+)
+
+type address struct {
+	City string `json:"city"`
+	Zip  int    `json:"zip,omitempty"`
+}
+
+type person struct {
+	address
+	Name   string `json:"name"`
+	Admin  bool
+	Tags   []string `json:"tags"`
+	Secret string   `json:"-"`
+	hidden string
+}
+
+func newServer(t *testing.T, maxBody int64) *httptest.Server {
+	t.Setenv("SHOGUN_HISTORY", filepath.Join(t.TempDir(), "history.jsonl"))
+
+	server := &Server{
+		Binary:  "demo",
+		MaxBody: maxBody,
+		Functions: []internals.ShogunFunc{
+			{
+				NS:       "register",
+				Name:     "Register",
+				Binary:   "demo",
+				Type:     internals.WithStructAndWriteCloserArgument,
+				Function: func(ctx context.Context, p person, w io.WriteCloser) error { return nil },
+				Flags:    internals.Flags{{Name: "loud", Type: internals.BoolFlag}},
+			},
+			{NS: "words", Name: "Words", Binary: "sub", Type: internals.WithStringSliceArgument},
+		},
+		Call: func(ctx context.Context, fn internals.ShogunFunc, args []string, flags []string, input io.Reader, output io.WriteCloser) error {
+			data, _ := io.ReadAll(input)
+			fmt.Fprintf(output, "%s %q %q", data, args, flags)
+
+			if len(args) != 0 && args[0] == "fail" {
+				return internals.InvalidInput(fmt.Errorf("cannot register %s", data))
+			}
+
+			return nil
+		},
+	}
+
+	ts := httptest.NewServer(server.Handler())
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+func TestFunctions(t *testing.T) {
+	ts := newServer(t, 0)
+
+	res, err := http.Get(ts.URL + "/api/functions")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer res.Body.Close()
+
+	var list []Function
+	if err := json.NewDecoder(res.Body).Decode(&list); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list) != 2 || list[0].ID != "demo/register" || list[1].ID != "sub/words" {
+		t.Fatalf("functions %+v, expected those of the binary before those of subpackages", list)
+	}
+
+	expected := Input{
+		Kind: InputStruct,
+		Type: "ui.person",
+		Fields: []Field{
+			{Name: "city", Type: FieldString, Go: "string"},
+			{Name: "zip", Type: FieldNumber, Go: "int"},
+			{Name: "name", Type: FieldString, Go: "string"},
+			{Name: "Admin", Type: FieldBoolean, Go: "bool"},
+			{Name: "tags", Type: FieldJSON, Go: "[]string"},
+		},
+	}
+
+	if !reflect.DeepEqual(list[0].Input, expected) {
+		t.Errorf("input of register is %+v, expected %+v", list[0].Input, expected)
+	}
+
+	if list[1].Input.Kind != InputArgs {
+		t.Errorf("input of words is %+v, expected arguments", list[1].Input)
+	}
+}
+
+func TestRun(t *testing.T) {
+	ts := newServer(t, 0)
+
+	call := func(body string) []Event {
+		res, err := http.Post(ts.URL+"/api/run/demo/register", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		defer res.Body.Close()
+
+		var events []Event
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			var event Event
+			if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+				t.Fatalf("invalid event %q: %s", scanner.Text(), err)
+			}
+
+			events = append(events, event)
+		}
+
+		return events
+	}
+
+	events := call(`{"input":"{\"name\":\"bat\"}","args":["x"],"flags":{"loud":"true","unknown":"1"}}`)
+	if len(events) != 3 || events[0].Event != "start" || events[2].Event != "done" {
+		t.Fatalf("events %+v, expected start, output and done", events)
+	}
+
+	if expected := `{"name":"bat"} ["x"] ["loud=true"]`; events[1].Data != expected {
+		t.Errorf("output %q, expected %q", events[1].Data, expected)
+	}
+
+	events = call(`{"input":"bat","args":["fail"]}`)
+	if last := events[len(events)-2]; last.Event != "error" || last.Error.Code != internals.CodeInvalidInput {
+		t.Errorf("failed run ended with %+v, expected its error envelope", last)
+	}
+
+	path, _ := history.Path()
+	records, err := history.Read(path, history.Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 2 || records[1].ID != events[0].ID {
+		t.Fatalf("history holds %+v, expected both runs", records)
+	}
+
+	if expected := []string{"register", "--loud=true", "x"}; !reflect.DeepEqual(records[0].Args, expected) {
+		t.Errorf("run was recorded as %q, expected %q", records[0].Args, expected)
+	}
+}
+
+func TestRequestsFromElsewhereRejected(t *testing.T) {
+	ts := newServer(t, 16)
+
+	tests := []struct {
+		name   string
+		host   string
+		origin string
+		body   string
+		status int
+	}{
+		{name: "page of the server", origin: ts.URL, body: `{}`, status: http.StatusOK},
+		{name: "localhost", host: "localhost:7070", body: `{}`, status: http.StatusOK},
+		{name: "other origin", origin: "http://evil.example", body: `{}`, status: http.StatusForbidden},
+		{name: "rebound host name", host: "evil.example:7070", origin: "http://evil.example:7070", body: `{}`, status: http.StatusForbidden},
+		{name: "body above the limit", body: `{"input":"` + strings.Repeat("a", 32) + `"}`, status: http.StatusRequestEntityTooLarge},
+		{name: "invalid run", body: `[`, status: http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		req, _ := http.NewRequest(http.MethodPost, ts.URL+"/api/run/sub/words", strings.NewReader(test.body))
+		if test.host != "" {
+			req.Host = test.host
+		}
+
+		if test.origin != "" {
+			req.Header.Set("Origin", test.origin)
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+
+		res.Body.Close()
+
+		if res.StatusCode != test.status {
+			t.Errorf("%s: status %d, expected %d", test.name, res.StatusCode, test.status)
+		}
+	}
+}
+
+func TestLoopback(t *testing.T) {
+	for host, expected := range map[string]bool{
+		"127.0.0.1:7070":     true,
+		"127.0.0.2":          true,
+		"[::1]:7070":         true,
+		"::1":                true,
+		"LOCALHOST:7070":     true,
+		"localhost":          true,
+		"192.168.1.4:7070":   false,
+		"evil.example":       false,
+		"localhost.evil.com": false,
+		"":                   false,
+	} {
+		if loopback(host) != expected {
+			t.Errorf("loopback(%q) returned %t", host, !expected)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/influx6/shogun/internals"
+)
+
+// Trigger defines a function called with the changed paths of a function's
+// watched files.
+type Trigger func(ctx context.Context, fn internals.ShogunFunc, paths []string)
+
+// Serve starts a watcher for every @watch annotation of the giving functions,
+// calling trigger with each batch of changed paths until the context is cancelled.
+func Serve(ctx context.Context, fns []internals.ShogunFunc, trigger Trigger, errs func(error)) error {
+	type watched struct {
+		fn      internals.ShogunFunc
+		watcher *Watcher
+	}
+
+	var watchers []watched
+
+	defer func() {
+		for _, item := range watchers {
+			item.watcher.Close()
+		}
+	}()
+
+	for _, fn := range fns {
+		for _, spec := range fn.Watches {
+			watcher, err := forSpec(spec)
+			if err != nil {
+				return err
+			}
+
+			watchers = append(watchers, watched{fn: fn, watcher: watcher})
+		}
+	}
+
+	var waiter sync.WaitGroup
+	waiter.Add(len(watchers))
+
+	for _, item := range watchers {
+		go func(item watched) {
+			defer waiter.Done()
+
+			item.watcher.Run(ctx, func(paths []string) {
+				trigger(ctx, item.fn, paths)
+			}, errs)
+		}(item)
+	}
+
+	waiter.Wait()
+	return ctx.Err()
+}
+
+// forSpec returns a Watcher for the directory and glob of giving Watch.
+func forSpec(spec internals.Watch) (*Watcher, error) {
+	root, err := filepath.Abs(spec.Root())
+	if err != nil {
+		return nil, err
+	}
+
+	glob := spec.Glob
+
+	// Watching a single file is watching its directory for its name.
+	if info, err := os.Stat(root); err != nil {
+		return nil, err
+	} else if !info.IsDir() {
+		glob = filepath.Base(root)
+		root = filepath.Dir(root)
+	}
+
+	watcher, err := New(spec.Debounce)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher.Dirs = func(path string, info os.FileInfo) bool {
+		return !strings.HasPrefix(info.Name(), ".")
+	}
+
+	watcher.Files = func(path string, _ os.FileInfo) bool {
+		return matchGlob(root, glob, path)
+	}
+
+	if err := watcher.Add(root, -1); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	return watcher, nil
+}
+
+// matchGlob matches globs with separators against the path relative to
+// root, and others against the base name of the path.
+func matchGlob(root string, glob string, path string) bool {
+	if glob == "" {
+		return true
+	}
+
+	target := filepath.Base(path)
+	if strings.ContainsRune(glob, '/') {
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return false
+		}
+
+		target = filepath.ToSlash(rel)
+	}
+
+	for _, pattern := range expandBraces(glob) {
+		if matched, err := filepath.Match(pattern, target); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandBraces returns the patterns of giving glob with its lists like `*.{go,tml}`
+// expanded, which filepath.Match does not support.
+func expandBraces(glob string) []string {
+	start := strings.IndexByte(glob, '{')
+	if start == -1 {
+		return []string{glob}
+	}
+
+	end := strings.IndexByte(glob[start:], '}')
+	if end == -1 {
+		return []string{glob}
+	}
+
+	end += start
+
+	var patterns []string
+	for _, choice := range strings.Split(glob[start+1:end], ",") {
+		patterns = append(patterns, expandBraces(glob[:start]+strings.TrimSpace(choice)+glob[end+1:])...)
+	}
+
+	return patterns
+}
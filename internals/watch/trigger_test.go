@@ -0,0 +1,123 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/influx6/shogun/internals"
+)
+
+func TestMatchGlob(t *testing.T) {
+	root := filepath.FromSlash("/src/app")
+
+	tests := []struct {
+		glob  string
+		path  string
+		match bool
+	}{
+		{glob: "", path: "/src/app/any/thing.bin", match: true},
+		{glob: "*.go", path: "/src/app/deep/main.go", match: true},
+		{glob: "*.go", path: "/src/app/main.go.orig"},
+		{glob: "*.{txt,md}", path: "/src/app/notes.md", match: true},
+		{glob: "*.{txt, md}", path: "/src/app/notes.md", match: true},
+		{glob: "*.{txt,md}", path: "/src/app/notes.markdown"},
+		{glob: "{a,b}-{1,2}.log", path: "/src/app/b-2.log", match: true},
+		{glob: "{a,b}-{1,2}.log", path: "/src/app/c-2.log"},
+		{glob: "css/*.scss", path: "/src/app/css/site.scss", match: true},
+		{glob: "css/*.scss", path: "/src/app/vendor/css/site.scss"},
+		{glob: "*.{go", path: "/src/app/*.{go", match: true},
+	}
+
+	for _, test := range tests {
+		if match := matchGlob(root, test.glob, filepath.FromSlash(test.path)); match != test.match {
+			t.Errorf("matchGlob(%q, %q) returned %t", test.glob, test.path, match)
+		}
+	}
+}
+
+func TestServeTriggersWatchingFunctions(t *testing.T) {
+	pkgDir := t.TempDir()
+	assets := filepath.Join(pkgDir, "assets")
+	if err := os.MkdirAll(filepath.Join(assets, ".cache"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	config := filepath.Join(pkgDir, "config.yaml")
+	if err := os.WriteFile(config, []byte("a: 1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fns := []internals.ShogunFunc{
+		{Name: "Assets", Watches: []internals.Watch{{Path: "./assets", Glob: "*.{txt,md}", Dir: pkgDir, Debounce: 20 * time.Millisecond}}},
+		{Name: "Reload", Watches: []internals.Watch{{Path: "config.yaml", Dir: pkgDir, Debounce: 20 * time.Millisecond}}},
+		{Name: "Idle"},
+	}
+
+	type call struct {
+		fn    string
+		paths []string
+	}
+
+	calls := make(chan call, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error)
+	go func() {
+		done <- Serve(ctx, fns, func(ctx context.Context, fn internals.ShogunFunc, paths []string) {
+			calls <- call{fn: fn.Name, paths: paths}
+		}, nil)
+	}()
+
+	// Serve adds its watchers within its own goroutine, so files change once they are.
+	time.Sleep(100 * time.Millisecond)
+
+	for _, name := range []string{"skip.go", ".cache/hidden.txt", "read.md"} {
+		if err := os.WriteFile(filepath.Join(assets, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := call{fn: "Assets", paths: []string{filepath.Join(assets, "read.md")}}
+	select {
+	case got := <-calls:
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("triggered %+v, expected %+v", got, expected)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Assets was not triggered")
+	}
+
+	if err := os.WriteFile(config, []byte("a: 2"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	expected = call{fn: "Reload", paths: []string{config}}
+	select {
+	case got := <-calls:
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("triggered %+v, expected %+v", got, expected)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Reload was not triggered")
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Serve returned %v, expected context.Canceled", err)
+	}
+}
+
+func TestServeFailsForMissingPaths(t *testing.T) {
+	fns := []internals.ShogunFunc{
+		{Name: "Assets", Watches: []internals.Watch{{Path: "missing", Dir: t.TempDir()}}},
+	}
+
+	err := Serve(context.Background(), fns, func(context.Context, internals.ShogunFunc, []string) {}, nil)
+	if !os.IsNotExist(err) {
+		t.Errorf("Serve returned %v, expected the missing path", err)
+	}
+}
@@ -0,0 +1,181 @@
+// Package watch provides a file system watcher which delivers batches of changed
+// paths once changes have settled for a debounce period.
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Filter defines a function which returns true if giving path should be
+// watched or reported. The info is nil for paths which no longer exists.
+type Filter func(path string, info os.FileInfo) bool
+
+// Watcher watches a set of directories for changes.
+type Watcher struct {
+	// Debounce sets the duration which must pass without new changes
+	// before a batch of changes is delivered.
+	Debounce time.Duration
+
+	// Dirs decides which directories are watched when walking a root or when new
+	// directories are created. All directories are watched if nil.
+	Dirs Filter
+
+	// Files decides which changed files are reported. All files are reported if nil.
+	Files Filter
+
+	notifier *fsnotify.Watcher
+	ml       sync.Mutex
+	roots    map[string]int
+}
+
+// New returns a new Watcher using giving debounce duration.
+func New(debounce time.Duration) (*Watcher, error) {
+	notifier, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Watcher{
+		Debounce: debounce,
+		notifier: notifier,
+		roots:    make(map[string]int),
+	}, nil
+}
+
+// Add adds giving directory to the watch list. If depth is above zero then
+// sub-directories up to that depth are equally watched, with a negative depth
+// watching all sub-directories.
+func (w *Watcher) Add(dir string, depth int) error {
+	dir = filepath.Clean(dir)
+
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			return nil
+		}
+
+		remaining := depth
+		if path != dir {
+			level := strings.Count(strings.TrimPrefix(path, dir), string(filepath.Separator))
+			if depth >= 0 && level > depth {
+				return filepath.SkipDir
+			}
+
+			if w.Dirs != nil && !w.Dirs(path, info) {
+				return filepath.SkipDir
+			}
+
+			if depth >= 0 {
+				remaining = depth - level
+			}
+		}
+
+		w.ml.Lock()
+		w.roots[path] = remaining
+		w.ml.Unlock()
+
+		return w.notifier.Add(path)
+	})
+}
+
+// Close stops the watcher.
+func (w *Watcher) Close() error {
+	return w.notifier.Close()
+}
+
+// Run delivers sorted batches of changed paths to fn until the context
+// is cancelled. Errors from the underline notifier are delivered to errs
+// if not nil.
+func (w *Watcher) Run(ctx context.Context, fn func(paths []string), errs func(error)) error {
+	changed := make(map[string]bool)
+
+	timer := time.NewTimer(w.Debounce)
+	if !timer.Stop() {
+		<-timer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case err, ok := <-w.notifier.Errors:
+			if !ok {
+				return nil
+			}
+
+			if errs != nil {
+				errs(err)
+			}
+		case event, ok := <-w.notifier.Events:
+			if !ok {
+				return nil
+			}
+
+			info, statErr := os.Stat(event.Name)
+
+			// Watch newly created directories if their parent watches its children.
+			if statErr == nil && info.IsDir() {
+				if event.Op&fsnotify.Create == fsnotify.Create {
+					if depth, ok := w.childDepth(filepath.Dir(event.Name)); ok {
+						if w.Dirs == nil || w.Dirs(event.Name, info) {
+							w.Add(event.Name, depth)
+						}
+					}
+				}
+
+				continue
+			}
+
+			if w.Files != nil && !w.Files(event.Name, info) {
+				continue
+			}
+
+			changed[event.Name] = true
+			timer.Reset(w.Debounce)
+		case <-timer.C:
+			if len(changed) == 0 {
+				continue
+			}
+
+			paths := make([]string, 0, len(changed))
+			for path := range changed {
+				paths = append(paths, path)
+			}
+
+			sort.Strings(paths)
+			changed = make(map[string]bool)
+
+			fn(paths)
+		}
+	}
+}
+
+// childDepth returns the depth with which children of giving directory are
+// to be watched, returning false if they are not to be watched.
+func (w *Watcher) childDepth(dir string) (int, bool) {
+	w.ml.Lock()
+	defer w.ml.Unlock()
+
+	depth, ok := w.roots[dir]
+	if !ok || depth == 0 {
+		return 0, false
+	}
+
+	if depth < 0 {
+		return depth, true
+	}
+
+	return depth - 1, true
+}
@@ -0,0 +1,159 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// watching runs a watcher of dir until the test ends, returning the channel its
+// batches are delivered into.
+func watching(t *testing.T, dir string, depth int, configure func(w *Watcher)) <-chan []string {
+	t.Helper()
+
+	w, err := New(50 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if configure != nil {
+		configure(w)
+	}
+
+	if err := w.Add(dir, depth); err != nil {
+		t.Fatal(err)
+	}
+
+	batches := make(chan []string, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		w.Run(ctx, func(paths []string) { batches <- paths }, func(err error) { t.Errorf("watcher failed: %s", err) })
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		w.Close()
+	})
+
+	return batches
+}
+
+func nextBatch(t *testing.T, batches <-chan []string) []string {
+	t.Helper()
+
+	select {
+	case paths := <-batches:
+		return paths
+	case <-time.After(5 * time.Second):
+		t.Fatal("no batch of changes was delivered")
+		return nil
+	}
+}
+
+func noBatch(t *testing.T, batches <-chan []string) {
+	t.Helper()
+
+	select {
+	case paths := <-batches:
+		t.Errorf("unexpected batch %q", paths)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func write(t *testing.T, path string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(path), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChangesAreDebouncedIntoSortedBatches(t *testing.T) {
+	dir := t.TempDir()
+	batches := watching(t, dir, 0, nil)
+
+	// Writes within the debounce period end up in one batch, each path once.
+	for _, name := range []string{"c.txt", "a.txt", "b.txt", "a.txt"} {
+		write(t, filepath.Join(dir, name))
+	}
+
+	expected := []string{filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt"), filepath.Join(dir, "c.txt")}
+	if paths := nextBatch(t, batches); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("batch %q, expected %q", paths, expected)
+	}
+
+	if err := os.Remove(filepath.Join(dir, "b.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	if paths := nextBatch(t, batches); !reflect.DeepEqual(paths, []string{filepath.Join(dir, "b.txt")}) {
+		t.Errorf("removal was delivered as %q", paths)
+	}
+}
+
+func TestFilters(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "node_modules"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	batches := watching(t, dir, -1, func(w *Watcher) {
+		w.Dirs = func(path string, info os.FileInfo) bool { return filepath.Base(path) != "node_modules" }
+		w.Files = func(path string, info os.FileInfo) bool { return strings.HasSuffix(path, ".go") }
+	})
+
+	write(t, filepath.Join(dir, "node_modules", "lib.go"))
+	write(t, filepath.Join(dir, "notes.md"))
+	noBatch(t, batches)
+
+	write(t, filepath.Join(dir, "main.go"))
+	if paths := nextBatch(t, batches); !reflect.DeepEqual(paths, []string{filepath.Join(dir, "main.go")}) {
+		t.Errorf("batch %q, expected only main.go", paths)
+	}
+}
+
+func TestCreatedDirectoriesFollowDepth(t *testing.T) {
+	t.Run("watched below the depth", func(t *testing.T) {
+		dir := t.TempDir()
+		batches := watching(t, dir, 2, nil)
+
+		nested := filepath.Join(dir, "a", "b")
+		if err := os.Mkdir(filepath.Join(dir, "a"), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		// New directories are added as their events arrive, so wait for each to settle.
+		time.Sleep(100 * time.Millisecond)
+		if err := os.Mkdir(nested, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		write(t, filepath.Join(nested, "deep.txt"))
+
+		if paths := nextBatch(t, batches); !reflect.DeepEqual(paths, []string{filepath.Join(nested, "deep.txt")}) {
+			t.Errorf("batch %q, expected the file within the created directories", paths)
+		}
+	})
+
+	t.Run("ignored at depth zero", func(t *testing.T) {
+		dir := t.TempDir()
+		batches := watching(t, dir, 0, nil)
+
+		if err := os.Mkdir(filepath.Join(dir, "a"), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		write(t, filepath.Join(dir, "a", "shallow.txt"))
+		noBatch(t, batches)
+	})
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/influx6/shogun/internals/jobs"
+	"github.com/minio/cli"
+)
+
+// superviseCommand is the hidden command with which shogun runs as the
+// supervisor of a background job.
+const superviseCommand = "supervise-job"
+
+func runAction(c *cli.Context) error {
+	if !c.Bool("detach") {
+		return mainAction(c)
+	}
+
+	if c.NArg() == 0 || c.Args().First() == "" {
+		fmt.Println("⡿ Run `shogun run -detach [Binary] [Function]` to run a function in the background.")
+		return nil
+	}
+
+	if err := buildQuietly(c); err != nil {
+		fmt.Println("⡿ Run `shogun build -dir=''` to build package directory first before running `shogun run -detach`.")
+		return err
+	}
+
+	shareRecording(c)
+
+	root, err := jobs.Root()
+	if err != nil {
+		return err
+	}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	var input io.Reader
+	if stdinHasData() {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		input = bytes.NewReader(data)
+	}
+
+	command := append([]string{filepath.Join(binPath(), c.Args().First())}, c.Args().Tail()...)
+
+	job, err := jobs.Create(root, command, currentDir, input)
+	if err != nil {
+		return err
+	}
+
+	if err := jobs.Start(job, self, superviseCommand); err != nil {
+		return err
+	}
+
+	fmt.Println(job.ID)
+	return nil
+}
+
+func superviseAction(c *cli.Context) error {
+	root, err := jobs.Root()
+	if err != nil {
+		return err
+	}
+
+	// The supervisor must outlive signals meant for the job. They are caught rather
+	// than ignored, as ignored signals would equally be ignored by the job's command.
+	signal.Notify(make(chan os.Signal, 1), os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+
+	return jobs.Supervise(root, c.Args().First())
+}
+
+func jobsAction(c *cli.Context) error {
+	root, err := jobs.Root()
+	if err != nil {
+		return err
+	}
+
+	list, err := jobs.List(root)
+	if err != nil {
+		return err
+	}
+
+	if len(list) == 0 {
+		fmt.Println("⠙ No jobs found.")
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tSTATUS\tEXIT\tSTARTED\tCOMMAND")
+
+	for _, job := range list {
+		exitCode := "-"
+		if job.Done() && job.Status != jobs.Lost {
+			exitCode = fmt.Sprintf("%d", job.ExitCode)
+		}
+
+		fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\t%s\n",
+			job.ID,
+			job.Status,
+			exitCode,
+			job.Started.Format(time.Stamp),
+			strings.Join(append([]string{filepath.Base(job.Command[0])}, job.Command[1:]...), " "),
+		)
+	}
+
+	return writer.Flush()
+}
+
+func logsAction(c *cli.Context) error {
+	if c.NArg() == 0 {
+		fmt.Println("⡿ Run `shogun logs -f [ID]` to follow the output of a job.")
+		return nil
+	}
+
+	root, err := jobs.Root()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signalContext(context.Background())
+	defer cancel()
+
+	return jobs.Logs(ctx, root, c.Args().First(), os.Stdout, os.Stderr, c.Bool("follow"))
+}
+
+func waitAction(c *cli.Context) error {
+	if c.NArg() == 0 {
+		fmt.Println("⡿ Run `shogun wait [ID]` to wait for a job to end.")
+		return nil
+	}
+
+	root, err := jobs.Root()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signalContext(context.Background())
+	defer cancel()
+
+	job, err := jobs.Wait(ctx, root, c.Args().First(), 200*time.Millisecond)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("⠙ Job %s %s (exitCode: %d)\n", job.ID, job.Status, job.ExitCode)
+
+	if job.Status != jobs.Succeeded {
+		code := job.ExitCode
+		if code <= 0 {
+			code = 1
+		}
+
+		return cli.NewExitError("", code)
+	}
+
+	return nil
+}
+
+func killAction(c *cli.Context) error {
+	if c.NArg() == 0 {
+		fmt.Println("⡿ Run `shogun kill [ID]` to cancel a running job.")
+		return nil
+	}
+
+	root, err := jobs.Root()
+	if err != nil {
+		return err
+	}
+
+	job, err := jobs.Load(root, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	if err := jobs.Kill(job); err != nil {
+		return err
+	}
+
+	fmt.Printf("⠙ Sent SIGTERM to job %s\n", job.ID)
+	return nil
+}
+
+// signalContext returns a context of parent which is cancelled on SIGINT or SIGTERM.
+func signalContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+
+		signal.Stop(signals)
+	}()
+
+	return ctx, cancel
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	gexec "os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/influx6/shogun/internals/lambda"
+	"github.com/minio/cli"
+)
+
+func lambdaAction(c *cli.Context) error {
+	if c.NArg() == 0 || c.String("handler") == "" {
+		fmt.Println("⡿ Run `shogun lambda -handler=greet -event=event.json demo` to invoke a function of a binary as AWS Lambda would.")
+		fmt.Println("⡿ Run `shogun lambda -handler=greet -addr=127.0.0.1:9001 demo` to take invocations over HTTP.")
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(c.String("timeout"))
+	if err != nil {
+		return fmt.Errorf("Invalid timeout %q: %s", c.String("timeout"), err)
+	}
+
+	// The event is read before the runtime starts, so it does not wait on a terminal.
+	var event []byte
+	if c.String("addr") == "" {
+		if event, err = readEvent(c.String("event")); err != nil {
+			return err
+		}
+	}
+
+	if err := buildQuietly(c); err != nil {
+		fmt.Println("⡿ Run `shogun build -dir=''` to build package directory first before running `shogun lambda`.")
+		return err
+	}
+
+	addr := c.String("addr")
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	emulator := lambda.NewEmulator(timeout)
+	server := &http.Server{Handler: emulator.Handler()}
+	go server.Serve(listener)
+	defer server.Close()
+
+	ctx, cancel := signalContext(context.Background())
+	defer cancel()
+
+	// The runtime's output is kept apart from the responses written to stdout.
+	runtime := gexec.Command(filepath.Join(binPath(), c.Args().First()), "lambda", "--handler", c.String("handler"))
+	runtime.Env = append(os.Environ(), lambda.APIEnv+"="+listener.Addr().String())
+	runtime.Stdout = os.Stderr
+	runtime.Stderr = os.Stderr
+
+	if err := runtime.Start(); err != nil {
+		return err
+	}
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- runtime.Wait()
+		cancel()
+	}()
+
+	defer func() {
+		runtime.Process.Signal(os.Interrupt)
+
+		select {
+		case <-exited:
+		case <-time.After(5 * time.Second):
+			runtime.Process.Kill()
+			<-exited
+		}
+	}()
+
+	if c.String("addr") != "" {
+		fmt.Fprintf(os.Stderr, "⡿ Runtime API listening on %q, invoke with `curl -d @event.json http://%s%s`\n", listener.Addr(), listener.Addr(), lambda.InvokePath)
+		<-ctx.Done()
+		return nil
+	}
+
+	res, err := emulator.Invoke(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	if res.Error != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", res.Error)
+		return cli.NewExitError("", 1)
+	}
+
+	os.Stdout.Write(res.Output)
+	return nil
+}
+
+// readEvent reads the event of an invocation from giving file, or from stdin when
+// it's not set, using an empty JSON object when stdin is a terminal.
+func readEvent(file string) ([]byte, error) {
+	if file != "" {
+		return os.ReadFile(file)
+	}
+
+	if stat, err := os.Stdin.Stat(); err == nil && stat.Mode()&os.ModeCharDevice != 0 {
+		return []byte("{}"), nil
+	}
+
+	return io.ReadAll(os.Stdin)
+}
@@ -16,13 +16,13 @@ import (
 	"text/template"
 
 	"github.com/fatih/color"
-	"github.com/influx6/faux/exec"
 	"github.com/influx6/faux/metrics"
 	"github.com/influx6/faux/metrics/custom"
 	"github.com/influx6/gobuild/build"
 	"github.com/influx6/moz/ast"
 	"github.com/influx6/moz/gen"
 	"github.com/influx6/shogun/internals/samurai"
+	"github.com/influx6/shogun/internals/trace"
 	"github.com/influx6/shogun/templates"
 	"github.com/minio/cli"
 )
@@ -69,7 +69,36 @@ func main() {
 	app.Description = "Become one with your functions"
 	app.CustomAppHelpTemplate = helpTemplate
 	app.Action = mainAction
-	app.Flags = []cli.Flag{}
+	app.Flags = []cli.Flag{
+		cli.BoolFlag{
+			Name:  "q,quiet",
+			Usage: "-q to hide the messages shogun prints around executed binaries",
+		},
+		cli.BoolFlag{
+			Name:   "record",
+			EnvVar: recordEnv,
+			Usage:  "-record to save invocations of functions into the history of shogun history",
+		},
+		cli.BoolFlag{
+			Name:   "record-input",
+			EnvVar: recordInputEnv,
+			Usage:  "-record-input to keep the input of recorded invocations, needed by shogun replay, rather than only its digest",
+		},
+		cli.StringFlag{
+			Name:  "trace",
+			Usage: "--trace=trace.json to write timing spans of shogun and executed binaries into the file",
+		},
+		cli.BoolFlag{
+			Name:   "no-daemon",
+			EnvVar: "SHOGUN_NO_DAEMON",
+			Usage:  "--no-daemon to always build and execute binaries instead of calling their running daemon",
+		},
+		cli.StringFlag{
+			Name:  "trace-format",
+			Value: "json",
+			Usage: "--trace-format=chrome to write spans as trace events for chrome://tracing or Perfetto",
+		},
+	}
 
 	app.Commands = []cli.Command{
 		{
@@ -153,6 +182,172 @@ func main() {
 				},
 			},
 		},
+		{
+			Name:   "watch",
+			Action: watchAction,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "d,dir",
+					Usage: "-dir=./katanas to watch specific directory instead of root.",
+				},
+				cli.StringFlag{
+					Name:  "bin,binDir",
+					Usage: "-bin=./ to save binary into giving bin.",
+				},
+				cli.StringFlag{
+					Name:  "cmd,cmdDir",
+					Usage: "-cmd=./cmd to build CLI package files into relative directory.",
+				},
+				cli.StringFlag{
+					Name:  "debounce",
+					Value: "300ms",
+					Usage: "-debounce=1s to set how long changes must settle before rebuilding",
+				},
+				cli.BoolFlag{
+					Name:  "single,singlepkg",
+					Usage: "-singlePkg=true to only bundle seperate command binaries",
+				},
+				cli.BoolFlag{
+					Name:  "v,verbose",
+					Usage: "-verbose to show hidden logs and operations",
+				},
+			},
+		},
+		{
+			Name:   "run",
+			Action: runAction,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "detach",
+					Usage: "-detach to run function in the background and print its job ID",
+				},
+				cli.BoolFlag{
+					Name:  "v,verbose",
+					Usage: "-verbose to show hidden logs and operations",
+				},
+			},
+		},
+		{
+			Name:   "jobs",
+			Action: jobsAction,
+		},
+		{
+			Name:   "logs",
+			Action: logsAction,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "f,follow",
+					Usage: "-f to keep printing output till the job ends",
+				},
+			},
+		},
+		{
+			Name:   "wait",
+			Action: waitAction,
+		},
+		{
+			Name:   "kill",
+			Action: killAction,
+		},
+		{
+			Name:   superviseCommand,
+			Action: superviseAction,
+			Hidden: true,
+		},
+		{
+			Name:   "history",
+			Action: historyAction,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "bin",
+					Usage: "-bin=katana-shell to only show invocations of giving binary",
+				},
+				cli.StringFlag{
+					Name:  "fn",
+					Usage: "-fn=draw to only show invocations of giving function",
+				},
+				cli.StringFlag{
+					Name:  "since",
+					Usage: "-since=24h to only show invocations within giving duration",
+				},
+				cli.BoolFlag{
+					Name:  "failed",
+					Usage: "-failed to only show failed invocations",
+				},
+				cli.IntFlag{
+					Name:  "n",
+					Usage: "-n=20 to only show the latest giving number of invocations",
+				},
+				cli.BoolFlag{
+					Name:  "json",
+					Usage: "-json to print invocations as JSON lines",
+				},
+			},
+		},
+		{
+			Name:   "replay",
+			Action: replayAction,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "v,verbose",
+					Usage: "-verbose to show hidden logs and operations",
+				},
+			},
+		},
+		{
+			Name:   "lambda",
+			Action: lambdaAction,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "handler",
+					Usage: "-handler=greet or -handler=sub/words to set function handling invocations",
+				},
+				cli.StringFlag{
+					Name:  "event",
+					Usage: "-event=event.json to set file of event to invoke with, read from stdin if not set",
+				},
+				cli.StringFlag{
+					Name:  "addr",
+					Usage: "-addr=127.0.0.1:9001 to take invocations over HTTP on giving address until interrupted",
+				},
+				cli.StringFlag{
+					Name:  "timeout",
+					Value: "30s",
+					Usage: "-timeout=30s to set deadline of invocations",
+				},
+				cli.BoolFlag{
+					Name:  "v,verbose",
+					Usage: "-verbose to show hidden logs and operations",
+				},
+			},
+		},
+		{
+			Name: "flow",
+			Subcommands: []cli.Command{
+				{
+					Name:   "run",
+					Action: flowRunAction,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "resume",
+							Usage: "-resume to continue a failed flow from its failed step",
+						},
+						cli.StringFlag{
+							Name:  "state",
+							Usage: "-state=./deploy.state.json to set file where step results are saved",
+						},
+						cli.StringFlag{
+							Name:  "bin,binDir",
+							Usage: "-bin=./ to set directory where binaries are located",
+						},
+						cli.BoolFlag{
+							Name:  "v,verbose",
+							Usage: "-verbose to show hidden logs and operations",
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:   "help",
 			Action: helpAction,
@@ -259,13 +454,13 @@ func helpAction(c *cli.Context) error {
 	events := metrics.New()
 
 	if c.Bool("verbose") {
-		events = metrics.New(custom.StackDisplay(os.Stdout))
+		events = metrics.New(custom.StackDisplay(os.Stderr))
 	}
 
 	var buildDone bool
 	if _, err := gexec.LookPath(c.Args().First()); err != nil {
-		if err := buildAction(c); err != nil {
-			fmt.Println("⡿ Run `shogun build -dir=''` to build package directory first before running `shogun [] [Command]`.")
+		if err := buildQuietly(c); err != nil {
+			fmt.Fprintln(os.Stderr, "⡿ Run `shogun build -dir=''` to build package directory first before running `shogun [] [Command]`.")
 			return nil
 		}
 
@@ -273,40 +468,17 @@ func helpAction(c *cli.Context) error {
 	}
 
 	if !buildDone {
-		if err := buildAction(c); err != nil {
+		if err := buildQuietly(c); err != nil {
 			// do nothing for now
 		}
 	}
 
-	binaryPath := binPath()
-
-	var command string
-
+	argv := []string{filepath.Join(binPath(), c.Args().First()), "help"}
 	if c.Bool("source") {
-		command = fmt.Sprintf("%s/%s help -s %s", binaryPath, c.Args().First(), strings.Join(c.Args().Tail(), " "))
-	} else {
-		command = fmt.Sprintf("%s/%s help %s", binaryPath, c.Args().First(), strings.Join(c.Args().Tail(), " "))
-	}
-
-	binCmd := exec.New(
-		exec.Async(),
-		exec.Command(command),
-		exec.Output(os.Stdout),
-		exec.Err(os.Stderr),
-	)
-
-	fmt.Printf("⡿ Executing %+q:\n", command)
-	exitCode, err := binCmd.ExecWithExitCode(context.Background(), events)
-	if err != nil {
-		events.Emit(metrics.Error(err))
-		return fmt.Errorf("command error: %+q", err)
+		argv = append(argv, "-s")
 	}
 
-	if exitCode > 0 {
-		return fmt.Errorf("command error (exitCode: %d)", exitCode)
-	}
-
-	return nil
+	return runBinary(c, events, append(argv, c.Args().Tail()...))
 }
 
 func mainAction(c *cli.Context) error {
@@ -316,10 +488,33 @@ func mainAction(c *cli.Context) error {
 		return nil
 	}
 
+	shareRecording(c)
+
+	ctx, endTrace, err := startTrace(c, context.Background())
+	if err != nil {
+		return err
+	}
+
+	defer endTrace()
+
+	ctx, span := trace.Start(ctx, "shogun "+c.Args().First())
+	defer span.End()
+
+	// A running daemon of the binary calls its functions without a build or a new process.
+	if !c.Bool("no-daemon") && !c.Bool("record") && c.String("trace") == "" {
+		if handled, err := runDaemon(c, ctx); handled {
+			return err
+		}
+	}
+
+	_, buildSpan := trace.Start(ctx, "build")
+
 	var buildDone bool
 	if _, err := gexec.LookPath(c.Args().First()); err != nil {
-		if err := buildAction(c); err != nil {
-			fmt.Println("⡿ Run `shogun build -dir=''` to build package directory first before running `shogun [] [Command]`.")
+		if err := buildQuietly(c); err != nil {
+			buildSpan.Fail(err)
+			buildSpan.End()
+			fmt.Fprintln(os.Stderr, "⡿ Run `shogun build -dir=''` to build package directory first before running `shogun [] [Command]`.")
 			return nil
 		}
 
@@ -327,47 +522,28 @@ func mainAction(c *cli.Context) error {
 	}
 
 	if !buildDone {
-		if err := buildAction(c); err != nil {
+		if err := buildQuietly(c); err != nil {
+			buildSpan.Fail(err)
+			buildSpan.End()
 			// do nothing for now
 			return err
 		}
 	}
 
+	buildSpan.End()
+
 	events := metrics.New()
 
 	if c.Bool("verbose") {
-		events = metrics.New(custom.StackDisplay(os.Stdout))
+		events = metrics.New(custom.StackDisplay(os.Stderr))
 	}
 
-	binaryPath := binPath()
-	command := fmt.Sprintf("%s/%s %s", binaryPath, c.Args().First(), strings.Join(c.Args().Tail(), " "))
-
-	var response, responseErr bytes.Buffer
-	binCmd := exec.New(
-		exec.Async(),
-		exec.Command(command),
-		exec.Output(&response),
-		exec.Err(&responseErr),
-		exec.Input(os.Stdin),
-	)
+	_, execSpan := trace.Start(ctx, "exec")
+	defer execSpan.End()
 
-	fmt.Printf("⡿ Executing %+q:\n", command)
-	exitCode, err := binCmd.ExecWithExitCode(context.Background(), events)
-	if err != nil {
-		events.Emit(metrics.Error(err))
-		return fmt.Errorf("Command Error: %+q\n %+s", err, responseErr.String())
-	}
-
-	if exitCode > 0 {
-		return fmt.Errorf("command error: exitCode: %d (%+q)", exitCode, responseErr.String())
-	}
-
-	if responseErr.Len() != 0 {
-		return fmt.Errorf("command error: exitCode: %d (%+q)", exitCode, responseErr.String())
-	}
-
-	fmt.Println(response.String())
-	return nil
+	err = runBinary(c, events, append([]string{filepath.Join(binPath(), c.Args().First())}, c.Args().Tail()...))
+	execSpan.Fail(err)
+	return err
 }
 
 func listAction(c *cli.Context) error {
@@ -417,7 +593,7 @@ func buildAction(c *cli.Context) error {
 	events := metrics.New()
 
 	if c.Bool("verbose") {
-		events = metrics.New(custom.StackDisplay(os.Stdout))
+		events = metrics.New(custom.StackDisplay(os.Stderr))
 	}
 
 	skipBuild := c.Bool("skipbuild")
@@ -608,3 +784,13 @@ func binPath() string {
 
 	return shogunBinPath
 }
+
+// stdinHasData returns true/false if data is piped into stdin.
+func stdinHasData() bool {
+	stat, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+
+	return stat.Mode()&os.ModeCharDevice == 0
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	gexec "os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/influx6/faux/metrics"
+	"github.com/influx6/shogun/internals/daemon"
+	"github.com/influx6/shogun/internals/trace"
+	"github.com/minio/cli"
+)
+
+// runBinary executes giving argv through proxy, printing what it executes to stderr
+// unless the `-q` flag is set, and exits with the exact exit code of the command.
+func runBinary(c *cli.Context, events metrics.Metrics, argv []string) error {
+	if !c.GlobalBool("quiet") {
+		fmt.Fprintf(os.Stderr, "⡿ Executing %+q:\n", strings.Join(argv, " "))
+	}
+
+	exitCode, err := proxy(argv)
+	if err != nil {
+		events.Emit(metrics.Error(err))
+		return fmt.Errorf("command error: %+q", err)
+	}
+
+	if exitCode != 0 {
+		return cli.NewExitError("", exitCode)
+	}
+
+	return nil
+}
+
+// buildQuietly runs buildAction for commands which proxy binaries, with what the
+// build prints to stdout written to stderr instead, or discarded with the `-q` flag,
+// so stdout only carries the output of functions.
+func buildQuietly(c *cli.Context) error {
+	stdout := os.Stdout
+	defer func() { os.Stdout = stdout }()
+
+	os.Stdout = os.Stderr
+
+	if c.GlobalBool("quiet") {
+		devnull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+		if err != nil {
+			return err
+		}
+
+		defer devnull.Close()
+		os.Stdout = devnull
+	}
+
+	return buildAction(c)
+}
+
+// proxy executes giving argv with the standard input, output and error of shogun,
+// so output is streamed as it is produced. It returns the exit code of the command,
+// which for commands ended by a signal is 128 plus the signal's number.
+func proxy(argv []string) (int, error) {
+	cmd := gexec.Command(argv[0], argv[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	// SIGINT from the terminal already reaches the command as it shares our process group,
+	// so it is only caught to keep shogun alive till the command ends. Other signals are
+	// usually sent to shogun alone and are forwarded.
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	if err := cmd.Start(); err != nil {
+		return -1, err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case sig := <-signals:
+				if sig != os.Interrupt {
+					cmd.Process.Signal(sig)
+				}
+			}
+		}
+	}()
+
+	err := cmd.Wait()
+	if err == nil {
+		return 0, nil
+	}
+
+	if _, ok := err.(*gexec.ExitError); !ok {
+		return -1, err
+	}
+
+	if status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return 128 + int(status.Signal()), nil
+	}
+
+	return cmd.ProcessState.ExitCode(), nil
+}
+
+// runDaemon calls the function of giving arguments through the daemon of its
+// binary if one listens on its socket, returning false if none does or it
+// rejected the call, which is then run by executing the binary.
+func runDaemon(c *cli.Context, ctx context.Context) (bool, error) {
+	path := daemon.SocketPath(c.Args().First())
+	if _, err := os.Stat(path); err != nil {
+		return false, nil
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return false, nil
+	}
+
+	ctx, span := trace.Start(ctx, "daemon")
+	defer span.End()
+
+	ctx, cancel := signalContext(ctx)
+	defer cancel()
+
+	req := daemon.Request{Args: c.Args().Tail(), Dir: dir, Env: os.Environ()}
+
+	if !c.GlobalBool("quiet") {
+		fmt.Fprintf(os.Stderr, "⡿ Calling %+q through its daemon:\n", strings.Join(c.Args(), " "))
+	}
+
+	exitCode, err := daemon.Run(ctx, path, req, os.Stdin, os.Stdout, os.Stderr)
+	if err != nil {
+		if ctx.Err() != nil {
+			return true, cli.NewExitError("", 130)
+		}
+
+		if !c.GlobalBool("quiet") {
+			fmt.Fprintf(os.Stderr, "⡿ Daemon did not take the call: %s\n", err)
+		}
+
+		return false, nil
+	}
+
+	if exitCode != 0 {
+		return true, cli.NewExitError("", exitCode)
+	}
+
+	return true, nil
+}
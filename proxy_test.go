@@ -0,0 +1,165 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// TestHelperProcess is the command executed by the proxy tests, behaving as
+// the arguments after "--" ask. It does nothing when run as a test.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("SHOGUN_PROXY_HELPER") != "1" {
+		return
+	}
+
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+
+	args = args[1:]
+
+	switch args[0] {
+	case "argv":
+		for _, arg := range args[1:] {
+			fmt.Println(strconv.Quote(arg))
+		}
+	case "exit":
+		fmt.Fprintln(os.Stderr, "failing")
+		code, _ := strconv.Atoi(args[1])
+		os.Exit(code)
+	case "signal":
+		syscall.Kill(os.Getpid(), syscall.SIGTERM)
+		time.Sleep(5 * time.Second)
+	case "stream":
+		fmt.Println("first")
+		line, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		fmt.Print("second " + line)
+	}
+
+	os.Exit(0)
+}
+
+// proxied runs proxy for the helper process with giving arguments, with the
+// standard input and output of shogun swapped for pipes the test controls.
+func proxied(t *testing.T, args ...string) (stdin io.WriteCloser, stdout *bufio.Reader, done <-chan int) {
+	t.Helper()
+	t.Setenv("SHOGUN_PROXY_HELPER", "1")
+
+	inR, inW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The files are swapped back once the command ends, so nothing but proxy reads them meanwhile.
+	stdinFile, stdoutFile, stderrFile := os.Stdin, os.Stdout, os.Stderr
+	os.Stdin, os.Stdout, os.Stderr = inR, outW, outW
+
+	exited := make(chan int, 1)
+	go func() {
+		defer outW.Close()
+		defer inR.Close()
+
+		code, err := proxy(append([]string{os.Args[0], "-test.run=TestHelperProcess", "--"}, args...))
+		os.Stdin, os.Stdout, os.Stderr = stdinFile, stdoutFile, stderrFile
+
+		if err != nil {
+			t.Errorf("proxy failed: %s", err)
+		}
+
+		exited <- code
+	}()
+
+	t.Cleanup(func() {
+		inW.Close()
+		outR.Close()
+	})
+
+	return inW, bufio.NewReader(outR), exited
+}
+
+func TestProxyPassesArgvUnchanged(t *testing.T) {
+	argv := []string{"name=bat ∞", `"quoted"`, "", "--flag", "$HOME", "a\nb", "*"}
+
+	_, stdout, done := proxied(t, append([]string{"argv"}, argv...)...)
+
+	output, _ := io.ReadAll(stdout)
+	if code := <-done; code != 0 {
+		t.Fatalf("command exited with %d", code)
+	}
+
+	var expected string
+	for _, arg := range argv {
+		expected += strconv.Quote(arg) + "\n"
+	}
+
+	if string(output) != expected {
+		t.Errorf("command received:\n%s\nexpected:\n%s", output, expected)
+	}
+}
+
+func TestProxyExitCodes(t *testing.T) {
+	for _, test := range []struct {
+		args []string
+		code int
+	}{
+		{args: []string{"exit", "7"}, code: 7},
+		{args: []string{"exit", "0"}, code: 0},
+		{args: []string{"signal"}, code: 128 + int(syscall.SIGTERM)},
+	} {
+		_, stdout, done := proxied(t, test.args...)
+		io.Copy(io.Discard, stdout)
+
+		if code := <-done; code != test.code {
+			t.Errorf("command %q exited with %d, expected %d", test.args, code, test.code)
+		}
+	}
+
+	if _, err := proxy([]string{"/missing/binary"}); err == nil {
+		t.Error("proxy of a missing binary succeeded")
+	}
+}
+
+func TestProxyStreamsOutput(t *testing.T) {
+	stdin, stdout, done := proxied(t, "stream")
+
+	// The first line arrives while the command still waits for input.
+	first := make(chan string)
+	go func() {
+		line, _ := stdout.ReadString('\n')
+		first <- line
+	}()
+
+	select {
+	case line := <-first:
+		if line != "first\n" {
+			t.Fatalf("first line %q", line)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("output was not streamed before the command ended")
+	}
+
+	io.WriteString(stdin, "input\n")
+
+	if line, _ := stdout.ReadString('\n'); line != "second input\n" {
+		t.Errorf("second line %q", line)
+	}
+
+	if code := <-done; code != 0 {
+		t.Errorf("command exited with %d", code)
+	}
+}
@@ -33,15 +33,15 @@ func init() {
 
 	files["shogun-add.tml"] = []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x73\x68\x6f\x67\x75\x6e\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x20\x6c\x6f\x77\x65\x72\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x0a")
 	files["shogun-in-pkg.tml"] = []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x73\x68\x6f\x67\x75\x6e\x0a\x0a\x2f\x2f\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x20\x6c\x6f\x77\x65\x72\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x20\x7d\x7d\x20\x70\x72\x6f\x76\x69\x64\x65\x73\x20\x65\x78\x70\x6f\x72\x74\x65\x64\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x61\x73\x20\x74\x61\x73\x6b\x73\x20\x72\x75\x6e\x6e\x61\x62\x6c\x65\x20\x66\x72\x6f\x6d\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x6c\x69\x6e\x65\x2e\x0a\x2f\x2f\x0a\x2f\x2f\x20\x40\x62\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x28\x6e\x61\x6d\x65\x20\x3d\x3e\x20\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x20\x7d\x7d\x29\x0a\x2f\x2f\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x0a\x0a\x0a\x2f\x2f\x20\x53\x6c\x61\x73\x68\x20\x69\x73\x20\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x74\x61\x73\x6b\x73\x20\x64\x75\x65\x20\x74\x6f\x20\x62\x65\x6c\x6f\x77\x20\x61\x6e\x6e\x6f\x74\x61\x74\x69\x6f\x6e\x2e\x0a\x2f\x2f\x20\x40\x64\x65\x66\x61\x75\x6c\x74\x0a\x66\x75\x6e\x63\x20\x53\x6c\x61\x73\x68\x28\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a")
-	files["shogun-pkg-fn-message-withsource.tml"] = []byte("\x53\x68\x6f\x67\x75\x6e\x20\x63\x6c\x61\x6e\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x50\x61\x74\x68\x7d\x7d\x0a\x53\x61\x6d\x75\x72\x61\x69\x20\x6b\x61\x74\x61\x6e\x61\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x20\x28\x41\x6c\x69\x61\x73\x20\x22\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x22\x29\x0a\x0a\x53\x59\x4e\x4f\x50\x53\x45\x53\x3a\x0a\x7b\x7b\x2e\x53\x79\x6e\x6f\x70\x73\x65\x73\x7d\x7d\x0a\x0a\x44\x45\x53\x43\x52\x49\x50\x54\x49\x4f\x4e\x3a\x0a\x7b\x7b\x2e\x44\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x7d\x7d\x0a\x0a\x41\x52\x47\x55\x4d\x45\x4e\x54\x20\x52\x45\x51\x55\x49\x52\x45\x4d\x45\x4e\x54\x53\x3a\x0a\x45\x72\x72\x6f\x72\x73\x20\x61\x72\x65\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x74\x6f\x20\x53\x54\x44\x45\x72\x72\x2e\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x49\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x73\x74\x72\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x73\x74\x72\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x46\x4c\x41\x47\x53\x3a\x0a\x7b\x7b\x69\x66\x20\x65\x71\x20\x28\x6c\x65\x6e\x20\x2e\x46\x6c\x61\x67\x73\x29\x20\x30\x7d\x7d\x4e\x6f\x6e\x65\x2e\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x2d\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x7b\x7b\x69\x66\x20\x6e\x6f\x74\x65\x6d\x70\x74\x79\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x20\x28\x45\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x56\x61\x72\x69\x61\x62\x6c\x65\x3a\x20\x7b\x7b\x2e\x45\x6e\x76\x56\x61\x72\x20\x7d\x7d\x29\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x3a\x20\x7b\x7b\x2e\x44\x65\x73\x63\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x53\x4f\x55\x52\x43\x45\x3a\x0a\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x0a")
-	files["shogun-pkg-fn-message.tml"] = []byte("\x53\x68\x6f\x67\x75\x6e\x20\x63\x6c\x61\x6e\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x50\x61\x74\x68\x7d\x7d\x0a\x53\x61\x6d\x75\x72\x61\x69\x20\x6b\x61\x74\x61\x6e\x61\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x20\x28\x41\x6c\x69\x61\x73\x20\x22\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x22\x29\x0a\x0a\x53\x59\x4e\x4f\x50\x53\x45\x53\x3a\x0a\x7b\x7b\x2e\x53\x79\x6e\x6f\x70\x73\x65\x73\x7d\x7d\x0a\x0a\x44\x45\x53\x43\x52\x49\x50\x54\x49\x4f\x4e\x3a\x0a\x7b\x7b\x2e\x44\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x7d\x7d\x0a\x0a\x41\x52\x47\x55\x4d\x45\x4e\x54\x20\x52\x45\x51\x55\x49\x52\x45\x4d\x45\x4e\x54\x53\x3a\x0a\x45\x72\x72\x6f\x72\x73\x20\x61\x72\x65\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x74\x6f\x20\x53\x54\x44\x45\x72\x72\x2e\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x49\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x73\x74\x72\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x73\x74\x72\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x46\x4c\x41\x47\x53\x3a\x0a\x7b\x7b\x69\x66\x20\x65\x71\x20\x28\x6c\x65\x6e\x20\x2e\x46\x6c\x61\x67\x73\x29\x20\x30\x7d\x7d\x4e\x6f\x6e\x65\x2e\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x2d\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x7b\x7b\x69\x66\x20\x6e\x6f\x74\x65\x6d\x70\x74\x79\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x28\x41\x6c\x69\x61\x73\x3a\x20\x45\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x56\x61\x72\x69\x61\x62\x6c\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x20\x7d\x7d\x29\x7b\x7b\x65\x6e\x64\x7d\x7d\x3a\x20\x7b\x7b\x2e\x44\x65\x73\x63\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a")
+	files["shogun-pkg-fn-message-withsource.tml"] = []byte("\x53\x68\x6f\x67\x75\x6e\x20\x63\x6c\x61\x6e\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x50\x61\x74\x68\x7d\x7d\x0a\x53\x61\x6d\x75\x72\x61\x69\x20\x6b\x61\x74\x61\x6e\x61\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x20\x28\x41\x6c\x69\x61\x73\x20\x22\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x22\x29\x0a\x0a\x53\x59\x4e\x4f\x50\x53\x45\x53\x3a\x0a\x7b\x7b\x2e\x53\x79\x6e\x6f\x70\x73\x65\x73\x7d\x7d\x0a\x0a\x44\x45\x53\x43\x52\x49\x50\x54\x49\x4f\x4e\x3a\x0a\x7b\x7b\x2e\x44\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x7d\x7d\x0a\x0a\x41\x52\x47\x55\x4d\x45\x4e\x54\x20\x52\x45\x51\x55\x49\x52\x45\x4d\x45\x4e\x54\x53\x3a\x0a\x45\x72\x72\x6f\x72\x73\x20\x61\x72\x65\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x74\x6f\x20\x53\x54\x44\x45\x72\x72\x2e\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x49\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x73\x74\x72\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x73\x74\x72\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x7b\x7b\x69\x66\x20\x6f\x72\x20\x28\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x29\x20\x28\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x29\x20\x28\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x29\x20\x28\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x29\x20\x28\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x29\x20\x28\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x29\x20\x7d\x7d\x49\x4e\x4c\x49\x4e\x45\x20\x49\x4e\x50\x55\x54\x3a\x0a\x4a\x53\x4f\x4e\x20\x69\x6e\x70\x75\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x67\x69\x76\x65\x6e\x20\x61\x73\x20\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x20\x77\x68\x69\x63\x68\x20\x61\x72\x65\x20\x6d\x65\x72\x67\x65\x64\x20\x69\x6e\x74\x6f\x20\x74\x68\x65\x20\x4a\x53\x4f\x4e\x20\x6f\x62\x6a\x65\x63\x74\x20\x72\x65\x61\x64\x20\x66\x72\x6f\x6d\x20\x53\x54\x44\x49\x6e\x2e\x0a\x2d\x20\x6e\x61\x6d\x65\x3d\x62\x61\x74\x3a\x20\x73\x65\x74\x73\x20\x66\x69\x65\x6c\x64\x20\x22\x6e\x61\x6d\x65\x22\x20\x74\x6f\x20\x74\x68\x65\x20\x73\x74\x72\x69\x6e\x67\x20\x22\x62\x61\x74\x22\x2e\x0a\x2d\x20\x61\x67\x65\x3a\x3d\x33\x3a\x20\x73\x65\x74\x73\x20\x66\x69\x65\x6c\x64\x20\x22\x61\x67\x65\x22\x20\x74\x6f\x20\x72\x61\x77\x20\x4a\x53\x4f\x4e\x2c\x20\x6c\x69\x6b\x65\x20\x6e\x75\x6d\x62\x65\x72\x73\x2c\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x73\x2c\x20\x61\x72\x72\x61\x79\x73\x20\x61\x6e\x64\x20\x6f\x62\x6a\x65\x63\x74\x73\x2e\x0a\x2d\x20\x61\x64\x64\x72\x65\x73\x73\x2e\x63\x69\x74\x79\x3d\x4c\x61\x67\x6f\x73\x3a\x20\x73\x65\x74\x73\x20\x66\x69\x65\x6c\x64\x20\x22\x63\x69\x74\x79\x22\x20\x6f\x66\x20\x74\x68\x65\x20\x6f\x62\x6a\x65\x63\x74\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x20\x22\x61\x64\x64\x72\x65\x73\x73\x22\x2e\x0a\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x46\x4c\x41\x47\x53\x3a\x0a\x7b\x7b\x69\x66\x20\x65\x71\x20\x28\x6c\x65\x6e\x20\x2e\x46\x6c\x61\x67\x73\x29\x20\x30\x7d\x7d\x4e\x6f\x6e\x65\x2e\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x2d\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x7b\x7b\x69\x66\x20\x6e\x6f\x74\x65\x6d\x70\x74\x79\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x20\x28\x45\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x56\x61\x72\x69\x61\x62\x6c\x65\x3a\x20\x7b\x7b\x2e\x45\x6e\x76\x56\x61\x72\x20\x7d\x7d\x29\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x3a\x20\x7b\x7b\x2e\x44\x65\x73\x63\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x53\x4f\x55\x52\x43\x45\x3a\x0a\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x0a")
+	files["shogun-pkg-fn-message.tml"] = []byte("\x53\x68\x6f\x67\x75\x6e\x20\x63\x6c\x61\x6e\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x50\x61\x74\x68\x7d\x7d\x0a\x53\x61\x6d\x75\x72\x61\x69\x20\x6b\x61\x74\x61\x6e\x61\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x20\x28\x41\x6c\x69\x61\x73\x20\x22\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x22\x29\x0a\x0a\x53\x59\x4e\x4f\x50\x53\x45\x53\x3a\x0a\x7b\x7b\x2e\x53\x79\x6e\x6f\x70\x73\x65\x73\x7d\x7d\x0a\x0a\x44\x45\x53\x43\x52\x49\x50\x54\x49\x4f\x4e\x3a\x0a\x7b\x7b\x2e\x44\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x7d\x7d\x0a\x0a\x41\x52\x47\x55\x4d\x45\x4e\x54\x20\x52\x45\x51\x55\x49\x52\x45\x4d\x45\x4e\x54\x53\x3a\x0a\x45\x72\x72\x6f\x72\x73\x20\x61\x72\x65\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x74\x6f\x20\x53\x54\x44\x45\x72\x72\x2e\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x49\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x4a\x53\x4f\x4e\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x73\x74\x72\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x0a\x50\x72\x6f\x64\x75\x63\x65\x73\x20\x4f\x75\x74\x67\x6f\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x4f\x75\x74\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x45\x78\x70\x65\x63\x74\x73\x20\x73\x74\x72\x69\x6e\x67\x20\x64\x61\x74\x61\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x53\x54\x44\x49\x6e\x2e\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x7b\x7b\x69\x66\x20\x6f\x72\x20\x28\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x29\x20\x28\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x29\x20\x28\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x29\x20\x28\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x29\x20\x28\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x29\x20\x28\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x29\x20\x7d\x7d\x49\x4e\x4c\x49\x4e\x45\x20\x49\x4e\x50\x55\x54\x3a\x0a\x4a\x53\x4f\x4e\x20\x69\x6e\x70\x75\x74\x20\x63\x61\x6e\x20\x62\x65\x20\x67\x69\x76\x65\x6e\x20\x61\x73\x20\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x20\x77\x68\x69\x63\x68\x20\x61\x72\x65\x20\x6d\x65\x72\x67\x65\x64\x20\x69\x6e\x74\x6f\x20\x74\x68\x65\x20\x4a\x53\x4f\x4e\x20\x6f\x62\x6a\x65\x63\x74\x20\x72\x65\x61\x64\x20\x66\x72\x6f\x6d\x20\x53\x54\x44\x49\x6e\x2e\x0a\x2d\x20\x6e\x61\x6d\x65\x3d\x62\x61\x74\x3a\x20\x73\x65\x74\x73\x20\x66\x69\x65\x6c\x64\x20\x22\x6e\x61\x6d\x65\x22\x20\x74\x6f\x20\x74\x68\x65\x20\x73\x74\x72\x69\x6e\x67\x20\x22\x62\x61\x74\x22\x2e\x0a\x2d\x20\x61\x67\x65\x3a\x3d\x33\x3a\x20\x73\x65\x74\x73\x20\x66\x69\x65\x6c\x64\x20\x22\x61\x67\x65\x22\x20\x74\x6f\x20\x72\x61\x77\x20\x4a\x53\x4f\x4e\x2c\x20\x6c\x69\x6b\x65\x20\x6e\x75\x6d\x62\x65\x72\x73\x2c\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x73\x2c\x20\x61\x72\x72\x61\x79\x73\x20\x61\x6e\x64\x20\x6f\x62\x6a\x65\x63\x74\x73\x2e\x0a\x2d\x20\x61\x64\x64\x72\x65\x73\x73\x2e\x63\x69\x74\x79\x3d\x4c\x61\x67\x6f\x73\x3a\x20\x73\x65\x74\x73\x20\x66\x69\x65\x6c\x64\x20\x22\x63\x69\x74\x79\x22\x20\x6f\x66\x20\x74\x68\x65\x20\x6f\x62\x6a\x65\x63\x74\x20\x69\x6e\x20\x66\x69\x65\x6c\x64\x20\x22\x61\x64\x64\x72\x65\x73\x73\x22\x2e\x0a\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x46\x4c\x41\x47\x53\x3a\x0a\x7b\x7b\x69\x66\x20\x65\x71\x20\x28\x6c\x65\x6e\x20\x2e\x46\x6c\x61\x67\x73\x29\x20\x30\x7d\x7d\x4e\x6f\x6e\x65\x2e\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x2d\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x7b\x7b\x69\x66\x20\x6e\x6f\x74\x65\x6d\x70\x74\x79\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x28\x41\x6c\x69\x61\x73\x3a\x20\x45\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x56\x61\x72\x69\x61\x62\x6c\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x20\x7d\x7d\x29\x7b\x7b\x65\x6e\x64\x7d\x7d\x3a\x20\x7b\x7b\x2e\x44\x65\x73\x63\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a")
 	files["shogun-pkg-inbin-list.tml"] = []byte("\x53\x68\x6f\x67\x75\x6e\x20\x63\x6c\x61\x6e\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x72\x6f\x6d\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x0a\x0a\xe2\xa1\xbf\x20\x53\x41\x4d\x55\x52\x41\x49\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x20\x7d\x7d\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x4d\x61\x69\x6e\x2e\x44\x65\x73\x63\x7d\x7d\x0a\x0a\x4b\x41\x54\x41\x4e\x41\x20\x43\x4f\x4d\x4d\x41\x4e\x44\x53\x3a\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x6e\x64\x65\x78\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x7d\x7d\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x65\x6c\x65\x6d\x2e\x4c\x69\x73\x74\x7d\x7d\x0a\xe2\xa0\x99\x20\x7b\x7b\x20\x2e\x4e\x61\x6d\x65\x20\x7d\x7d\x7b\x7b\x24\x65\x6c\x65\x6d\x2e\x53\x70\x61\x63\x65\x46\x6f\x72\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x7b\x7b\x2e\x53\x79\x6e\x6f\x70\x73\x65\x73\x7d\x7d\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x6e\x6f\x74\x65\x71\x75\x61\x6c\x20\x28\x6c\x65\x6e\x20\x2e\x53\x75\x62\x73\x29\x20\x30\x7d\x7d\x4f\x54\x48\x45\x52\x20\x53\x41\x4d\x55\x52\x41\x49\x20\x43\x4f\x4d\x4d\x41\x4e\x44\x53\x3a\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\xe2\xa1\xbf\x20\x7b\x7b\x20\x24\x65\x6c\x65\x6d\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x20\x7d\x7d\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x24\x65\x6c\x65\x6d\x2e\x44\x65\x73\x63\x7d\x7d\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x55\x53\x49\x4e\x47\x20\x43\x4f\x4e\x54\x45\x58\x54\x3a\x0a\x0a\x54\x6f\x20\x70\x72\x6f\x76\x69\x64\x65\x20\x61\x20\x64\x75\x72\x61\x74\x69\x6f\x6e\x20\x74\x69\x6d\x65\x20\x66\x6f\x72\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6c\x69\x66\x65\x74\x69\x6d\x65\x20\x77\x68\x65\x72\x65\x20\x63\x61\x6e\x63\x65\x6c\x61\x62\x6c\x65\x20\x63\x6f\x6e\x74\x65\x78\x74\x73\x20\x6f\x72\x20\x67\x6f\x6f\x67\x6c\x65\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x61\x72\x65\x0a\x75\x73\x65\x64\x2c\x20\x74\x68\x65\x6e\x20\x72\x65\x73\x6f\x72\x74\x20\x74\x6f\x20\x75\x73\x65\x20\x74\x68\x65\x20\x22\x2d\x74\x22\x20\x6f\x72\x20\x22\x2d\x74\x69\x6d\x65\x6f\x75\x74\x22\x20\x66\x6c\x61\x67\x20\x2e\x65\x2e\x67\x20\x22\x2d\x74\x3d\x34\x30\x6d\x22\x2c\x20\x22\x2d\x74\x69\x6d\x65\x6f\x75\x74\x3d\x34\x30\x6d\x22\x2e\x0a\x0a\x48\x45\x4c\x50\x3a\x0a\x0a\x54\x6f\x20\x73\x65\x65\x20\x6d\x6f\x72\x65\x20\x6f\x6e\x20\x65\x61\x63\x68\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x3a\x0a\x0a\x20\x20\x68\x65\x6c\x70\x20\x5b\x63\x6f\x6d\x6d\x61\x6e\x64\x4e\x61\x6d\x65\x5d\x0a\x0a\x54\x6f\x20\x73\x65\x65\x20\x6d\x6f\x72\x65\x20\x6f\x6e\x20\x65\x61\x63\x68\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x77\x69\x74\x68\x20\x73\x6f\x75\x72\x63\x65\x20\x61\x6e\x64\x20\x66\x75\x6c\x6c\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x0a\x0a\x20\x20\x68\x65\x6c\x70\x20\x2d\x73\x20\x5b\x63\x6f\x6d\x6d\x61\x6e\x64\x4e\x61\x6d\x65\x5d\x0a\x0a\x7b\x7b\x20\x69\x66\x20\x6e\x6f\x74\x65\x71\x75\x61\x6c\x20\x28\x6c\x65\x6e\x20\x2e\x53\x75\x62\x73\x29\x20\x30\x7d\x7d\x54\x6f\x20\x73\x65\x65\x20\x6d\x6f\x72\x65\x20\x6f\x6e\x20\x65\x61\x63\x68\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x3a\x0a\x0a\x20\x20\x68\x65\x6c\x70\x20\x5b\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x5d\x20\x5b\x63\x6f\x6d\x6d\x61\x6e\x64\x4e\x61\x6d\x65\x5d\x0a\x0a\x20\x20\x68\x65\x6c\x70\x20\x2d\x73\x20\x5b\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x5d\x20\x5b\x63\x6f\x6d\x6d\x61\x6e\x64\x4e\x61\x6d\x65\x5d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a")
 	files["shogun-pkg-list.tml"] = []byte("\x53\x68\x6f\x67\x75\x6e\x20\x63\x6c\x61\x6e\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x0a\x0a\xe2\xa1\xbf\x20\x53\x41\x4d\x55\x52\x41\x49\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x4e\x61\x6d\x65\x20\x7d\x7d\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x4d\x61\x69\x6e\x2e\x44\x65\x73\x63\x7d\x7d\x0a\x0a\x4b\x41\x54\x41\x4e\x41\x20\x43\x4f\x4d\x4d\x41\x4e\x44\x53\x3a\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x69\x6e\x64\x65\x78\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x4c\x69\x73\x74\x7d\x7d\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x65\x6c\x65\x6d\x2e\x4c\x69\x73\x74\x7d\x7d\x0a\x20\x20\xe2\xa0\x99\x20\x7b\x7b\x20\x2e\x4e\x61\x6d\x65\x20\x7d\x7d\x7b\x7b\x24\x65\x6c\x65\x6d\x2e\x53\x70\x61\x63\x65\x46\x6f\x72\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x7b\x7b\x2e\x53\x79\x6e\x6f\x70\x73\x65\x73\x7d\x7d\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x0a\x4f\x54\x48\x45\x52\x20\x53\x41\x4d\x55\x52\x41\x49\x20\x43\x4f\x4d\x4d\x41\x4e\x44\x53\x3a\x0a\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x6e\x61\x6d\x65\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\xe2\xa1\xbf\x20\x7b\x7b\x20\x24\x65\x6c\x65\x6d\x2e\x4e\x61\x6d\x65\x20\x7d\x7d\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x24\x65\x6c\x65\x6d\x2e\x44\x65\x73\x63\x7d\x7d\x0a\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a")
 	files["shogun-src-pkg-content.tml"] = []byte("\x70\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x2e\x50\x6b\x67\x4e\x61\x6d\x65\x7d\x7d\x0a\x0a\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x0a")
 	files["shogun-src-pkg-hash.tml"] = []byte("\x7b\x7b\x2e\x48\x61\x73\x68\x7d\x7d\x0a")
 	files["shogun-src-pkg-help-format.tml"] = []byte("\x4e\x41\x4d\x45\x3a\x0a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x2d\x20\x7b\x7b\x2e\x55\x73\x61\x67\x65\x7d\x7d\x0a\x0a\x56\x45\x52\x53\x49\x4f\x4e\x3a\x0a\x7b\x7b\x2e\x56\x65\x72\x73\x69\x6f\x6e\x7d\x7d\x0a\x0a\x44\x45\x53\x43\x52\x49\x50\x54\x49\x4f\x4e\x3a\x0a\x7b\x7b\x2e\x44\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x7d\x7d\x0a\x0a\x55\x53\x41\x47\x45\x3a\x0a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x7b\x7b\x69\x66\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x5b\x66\x6c\x61\x67\x73\x5d\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x63\x6f\x6d\x6d\x61\x6e\x64\x7b\x7b\x69\x66\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x20\x5b\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x2e\x2e\x5d\x0a\x0a\x43\x4f\x4d\x4d\x41\x4e\x44\x53\x3a\x0a\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x7d\x7d\x7b\x7b\x6a\x6f\x69\x6e\x20\x2e\x4e\x61\x6d\x65\x73\x20\x22\x2c\x20\x22\x7d\x7d\x7b\x7b\x20\x22\x5c\x74\x22\x20\x7d\x7d\x7b\x7b\x2e\x55\x73\x61\x67\x65\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x69\x66\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x46\x4c\x41\x47\x53\x3a\x0a\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x7b\x7b\x2e\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a")
-	files["shogun-src-pkg-main.tml"] = []byte("\x70\x61\x63\x6b\x61\x67\x65\x20\x6d\x61\x69\x6e\x0a\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0a\x09\x22\x66\x6d\x74\x22\x0a\x09\x22\x69\x6f\x22\x0a\x09\x22\x6f\x73\x22\x0a\x09\x22\x73\x74\x72\x69\x6e\x67\x73\x22\x0a\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x66\x61\x74\x69\x68\x2f\x63\x6f\x6c\x6f\x72\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x69\x6e\x69\x6f\x2f\x63\x6c\x69\x22\x0a\x09\x70\x6b\x67\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4d\x61\x69\x6e\x50\x61\x63\x6b\x61\x67\x65\x20\x7d\x7d\x0a\x29\x0a\x0a\x0a\x2f\x2f\x20\x76\x61\x72\x73\x20\x2e\x2e\x2e\x0a\x76\x61\x72\x20\x28\x0a\x20\x67\x72\x65\x65\x6e\x20\x3d\x20\x63\x6f\x6c\x6f\x72\x2e\x4e\x65\x77\x28\x63\x6f\x6c\x6f\x72\x2e\x46\x67\x47\x72\x65\x65\x6e\x29\x0a\x20\x62\x69\x6e\x48\x61\x73\x68\x20\x3d\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x48\x61\x73\x68\x7d\x7d\x0a\x20\x62\x69\x6e\x4e\x61\x6d\x65\x20\x3d\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x20\x7d\x7d\x0a\x20\x56\x65\x72\x73\x69\x6f\x6e\x20\x3d\x20\x67\x72\x65\x65\x6e\x2e\x53\x70\x72\x69\x6e\x74\x66\x28\x22\x31\x2e\x30\x2e\x30\x22\x29\x0a\x20\x68\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x20\x3d\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x54\x72\x69\x6d\x53\x70\x61\x63\x65\x28\x60\x7b\x7b\x2e\x48\x65\x6c\x70\x46\x6f\x72\x6d\x61\x74\x20\x7d\x7d\x60\x29\x0a\x20\x63\x75\x73\x74\x6f\x6d\x48\x65\x6c\x70\x54\x65\x6d\x70\x6c\x61\x74\x65\x20\x3d\x20\x60\x7b\x7b\x2e\x43\x75\x73\x74\x6f\x6d\x48\x65\x6c\x70\x54\x65\x6d\x70\x6c\x61\x74\x65\x7d\x7d\x60\x0a\x29\x0a\x0a\x66\x75\x6e\x63\x20\x6d\x61\x69\x6e\x28\x29\x7b\x0a\x09\x61\x70\x70\x20\x3a\x3d\x20\x63\x6c\x69\x2e\x4e\x65\x77\x41\x70\x70\x28\x29\x0a\x09\x61\x70\x70\x2e\x4e\x61\x6d\x65\x20\x3d\x20\x22\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x22\x0a\x09\x61\x70\x70\x2e\x55\x73\x61\x67\x65\x20\x3d\x20\x22\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x20\x5b\x63\x6f\x6d\x6d\x61\x6e\x64\x5d\x22\x0a\x09\x61\x70\x70\x2e\x56\x65\x72\x73\x69\x6f\x6e\x20\x3d\x20\x56\x65\x72\x73\x69\x6f\x6e\x0a\x09\x61\x70\x70\x2e\x43\x75\x73\x74\x6f\x6d\x41\x70\x70\x48\x65\x6c\x70\x54\x65\x6d\x70\x6c\x61\x74\x65\x20\x3d\x20\x68\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x0a\x09\x61\x70\x70\x2e\x44\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x20\x3d\x20\x22\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x64\x20\x62\x79\x20\x73\x68\x6f\x67\x75\x6e\x22\x0a\x09\x61\x70\x70\x2e\x41\x63\x74\x69\x6f\x6e\x20\x3d\x20\x6d\x61\x69\x6e\x41\x63\x74\x69\x6f\x6e\x0a\x0a\x09\x61\x70\x70\x2e\x46\x6c\x61\x67\x73\x20\x3d\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x74\x2c\x74\x69\x6d\x65\x6f\x75\x74\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x74\x3d\x34\x6d\x20\x74\x6f\x20\x73\x65\x74\x20\x74\x69\x6d\x65\x6f\x75\x74\x20\x66\x6f\x72\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x75\x73\x69\x6e\x67\x20\x63\x6f\x6e\x74\x65\x78\x74\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x7d\x0a\x0a\x09\x61\x70\x70\x2e\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x3d\x20\x5b\x5d\x63\x6c\x69\x2e\x43\x6f\x6d\x6d\x61\x6e\x64\x7b\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x68\x65\x6c\x70\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x68\x65\x6c\x70\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x09\x46\x6c\x61\x67\x73\x3a\x20\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x73\x2c\x73\x6f\x75\x72\x63\x65\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x73\x6f\x75\x72\x63\x65\x20\x74\x6f\x20\x73\x68\x6f\x77\x20\x73\x6f\x75\x72\x63\x65\x20\x6f\x66\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x61\x73\x20\x77\x65\x6c\x6c\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x7d\x0a\x0a\x09\x61\x70\x70\x2e\x52\x75\x6e\x41\x6e\x64\x45\x78\x69\x74\x4f\x6e\x45\x72\x72\x6f\x72\x28\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x68\x65\x6c\x70\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x69\x66\x20\x63\x2e\x4e\x41\x72\x67\x28\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x20\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x6c\x6e\x28\x68\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x29\x0a\x09\x09\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x48\x65\x6c\x70\x28\x0a\x09\x09\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x73\x6f\x75\x72\x63\x65\x22\x29\x2c\x0a\x09\x09\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x46\x69\x72\x73\x74\x28\x29\x2c\x0a\x09\x09\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x54\x61\x69\x6c\x28\x29\x2c\x0a\x09\x09\x6f\x73\x2e\x53\x74\x64\x69\x6e\x2c\x0a\x09\x09\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x7d\x2c\x0a\x09\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x6d\x61\x69\x6e\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x69\x6e\x70\x75\x74\x20\x3a\x3d\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x28\x6f\x73\x2e\x53\x74\x64\x69\x6e\x29\x0a\x0a\x09\x66\x6c\x61\x67\x73\x2c\x20\x5f\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x69\x6c\x74\x65\x72\x46\x6c\x61\x67\x73\x28\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x54\x61\x69\x6c\x28\x29\x29\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x28\x0a\x09\x09\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x46\x69\x72\x73\x74\x28\x29\x2c\x0a\x09\x09\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x54\x61\x69\x6c\x28\x29\x2c\x0a\x09\x09\x66\x6c\x61\x67\x73\x2c\x0a\x09\x09\x69\x6e\x70\x75\x74\x2c\x0a\x09\x09\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x7d\x2c\x0a\x09\x09\x74\x6d\x2c\x0a\x09\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x69\x66\x20\x65\x72\x72\x20\x3d\x3d\x20\x70\x6b\x67\x2e\x45\x72\x72\x4e\x6f\x44\x65\x66\x61\x75\x6c\x74\x20\x7b\x0a\x09\x09\x20\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x6c\x6e\x28\x68\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x29\x0a\x09\x09\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x64\x65\x66\x65\x72\x20\x6f\x73\x2e\x45\x78\x69\x74\x28\x31\x29\x0a\x0a\x09\x09\x2f\x2f\x20\x57\x72\x69\x74\x65\x20\x65\x72\x72\x6f\x72\x20\x74\x6f\x20\x73\x74\x64\x65\x72\x72\x20\x61\x6e\x64\x20\x6f\x6e\x6c\x79\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x20\x69\x66\x20\x61\x74\x74\x65\x6d\x70\x74\x20\x74\x6f\x20\x77\x72\x69\x74\x65\x20\x6a\x73\x6f\x6e\x20\x66\x61\x69\x6c\x65\x64\x2e\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x45\x6e\x63\x6f\x64\x65\x72\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x29\x2e\x45\x6e\x63\x6f\x64\x65\x28\x73\x74\x72\x75\x63\x74\x7b\x0a\x09\x09\x09\x45\x72\x72\x20\x65\x72\x72\x6f\x72\x20\x60\x6a\x73\x6f\x6e\x3a\x22\x65\x72\x72\x6f\x72\x22\x60\x0a\x09\x09\x09\x4d\x65\x74\x68\x6f\x64\x20\x73\x74\x72\x69\x6e\x67\x20\x60\x6a\x73\x6f\x6e\x3a\x22\x6d\x65\x74\x68\x6f\x64\x22\x60\x0a\x09\x09\x09\x41\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x20\x60\x6a\x73\x6f\x6e\x3a\x22\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x22\x60\x0a\x09\x09\x09\x4d\x65\x73\x73\x61\x67\x65\x20\x73\x74\x72\x69\x6e\x67\x20\x60\x6a\x73\x6f\x6e\x3a\x22\x6d\x65\x73\x73\x61\x67\x65\x22\x60\x0a\x09\x09\x09\x54\x69\x6d\x65\x6f\x75\x74\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x20\x60\x6a\x73\x6f\x6e\x3a\x22\x64\x75\x72\x61\x74\x69\x6f\x6e\x22\x60\x0a\x09\x09\x7d\x7b\x0a\x09\x09\x09\x45\x72\x72\x3a\x20\x65\x72\x72\x2c\x0a\x09\x09\x09\x54\x69\x6d\x65\x6f\x75\x74\x3a\x20\x74\x6d\x2c\x0a\x09\x09\x09\x4d\x65\x73\x73\x61\x67\x65\x3a\x20\x65\x72\x72\x2e\x45\x72\x72\x6f\x72\x28\x29\x2c\x0a\x09\x09\x09\x41\x72\x67\x73\x3a\x20\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x54\x61\x69\x6c\x28\x29\x2c\x0a\x09\x09\x09\x4d\x65\x74\x68\x6f\x64\x3a\x20\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x46\x69\x72\x73\x74\x28\x29\x2c\x0a\x09\x09\x7d\x29\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x74\x79\x70\x65\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x20\x73\x74\x72\x75\x63\x74\x7b\x0a\x09\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x0a\x7d\x0a\x0a\x2f\x2f\x20\x43\x6c\x6f\x73\x65\x20\x64\x6f\x65\x73\x20\x6e\x6f\x74\x68\x69\x6e\x67\x2e\x0a\x66\x75\x6e\x63\x20\x28\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x29\x20\x43\x6c\x6f\x73\x65\x28\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x2f\x2f\x20\x73\x74\x64\x69\x6e\x48\x61\x73\x44\x61\x74\x61\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x72\x75\x65\x2f\x66\x61\x6c\x73\x65\x20\x69\x66\x20\x64\x61\x74\x61\x20\x69\x73\x20\x61\x6c\x6c\x6f\x63\x61\x74\x65\x64\x20\x69\x6e\x74\x6f\x20\x73\x74\x64\x69\x6e\x2e\x0a\x66\x75\x6e\x63\x20\x73\x74\x64\x69\x6e\x48\x61\x73\x44\x61\x74\x61\x28\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x0a\x09\x73\x74\x61\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x53\x74\x64\x69\x6e\x2e\x53\x74\x61\x74\x28\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x73\x74\x61\x74\x2e\x53\x69\x7a\x65\x28\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x74\x72\x75\x65\x0a\x7d\x0a")
+	files["shogun-src-pkg-main.tml"] = []byte("\x70\x61\x63\x6b\x61\x67\x65\x20\x6d\x61\x69\x6e\x0a\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0a\x09\x22\x62\x79\x74\x65\x73\x22\x0a\x09\x22\x63\x6f\x6e\x74\x65\x78\x74\x22\x0a\x09\x22\x65\x6e\x63\x6f\x64\x69\x6e\x67\x2f\x6a\x73\x6f\x6e\x22\x0a\x09\x22\x65\x72\x72\x6f\x72\x73\x22\x0a\x09\x22\x66\x6c\x61\x67\x22\x0a\x09\x22\x66\x6d\x74\x22\x0a\x09\x22\x69\x6f\x22\x0a\x09\x22\x6e\x65\x74\x22\x0a\x09\x22\x6e\x65\x74\x2f\x68\x74\x74\x70\x22\x0a\x09\x22\x6e\x65\x74\x2f\x68\x74\x74\x70\x2f\x63\x67\x69\x22\x0a\x09\x22\x6e\x65\x74\x2f\x68\x74\x74\x70\x2f\x66\x63\x67\x69\x22\x0a\x09\x22\x6f\x73\x22\x0a\x09\x22\x70\x61\x74\x68\x2f\x66\x69\x6c\x65\x70\x61\x74\x68\x22\x0a\x09\x22\x73\x74\x72\x69\x6e\x67\x73\x22\x0a\x09\x22\x74\x69\x6d\x65\x22\x0a\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x66\x61\x74\x69\x68\x2f\x63\x6f\x6c\x6f\x72\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x62\x61\x74\x63\x68\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x64\x61\x65\x6d\x6f\x6e\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x66\x6c\x6f\x77\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x68\x69\x73\x74\x6f\x72\x79\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x6c\x61\x6d\x62\x64\x61\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x6d\x63\x70\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x73\x63\x68\x65\x64\x75\x6c\x65\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x73\x65\x72\x76\x65\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x73\x70\x6f\x6f\x6c\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x74\x72\x61\x63\x65\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x75\x69\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x77\x61\x74\x63\x68\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x6d\x69\x6e\x69\x6f\x2f\x63\x6c\x69\x22\x0a\x09\x70\x6b\x67\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4d\x61\x69\x6e\x50\x61\x63\x6b\x61\x67\x65\x20\x7d\x7d\x0a\x29\x0a\x0a\x0a\x2f\x2f\x20\x76\x61\x72\x73\x20\x2e\x2e\x2e\x0a\x76\x61\x72\x20\x28\x0a\x20\x67\x72\x65\x65\x6e\x20\x3d\x20\x63\x6f\x6c\x6f\x72\x2e\x4e\x65\x77\x28\x63\x6f\x6c\x6f\x72\x2e\x46\x67\x47\x72\x65\x65\x6e\x29\x0a\x20\x62\x69\x6e\x48\x61\x73\x68\x20\x3d\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x48\x61\x73\x68\x7d\x7d\x0a\x20\x62\x69\x6e\x4e\x61\x6d\x65\x20\x3d\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x20\x7d\x7d\x0a\x20\x62\x69\x6e\x56\x65\x72\x73\x69\x6f\x6e\x20\x3d\x20\x22\x31\x2e\x30\x2e\x30\x22\x0a\x20\x56\x65\x72\x73\x69\x6f\x6e\x20\x3d\x20\x67\x72\x65\x65\x6e\x2e\x53\x70\x72\x69\x6e\x74\x28\x62\x69\x6e\x56\x65\x72\x73\x69\x6f\x6e\x29\x0a\x20\x68\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x20\x3d\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x54\x72\x69\x6d\x53\x70\x61\x63\x65\x28\x60\x7b\x7b\x2e\x48\x65\x6c\x70\x46\x6f\x72\x6d\x61\x74\x20\x7d\x7d\x60\x29\x0a\x20\x63\x75\x73\x74\x6f\x6d\x48\x65\x6c\x70\x54\x65\x6d\x70\x6c\x61\x74\x65\x20\x3d\x20\x60\x7b\x7b\x2e\x43\x75\x73\x74\x6f\x6d\x48\x65\x6c\x70\x54\x65\x6d\x70\x6c\x61\x74\x65\x7d\x7d\x60\x0a\x29\x0a\x0a\x66\x75\x6e\x63\x20\x6d\x61\x69\x6e\x28\x29\x7b\x0a\x09\x61\x70\x70\x20\x3a\x3d\x20\x63\x6c\x69\x2e\x4e\x65\x77\x41\x70\x70\x28\x29\x0a\x09\x61\x70\x70\x2e\x4e\x61\x6d\x65\x20\x3d\x20\x22\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x22\x0a\x09\x61\x70\x70\x2e\x55\x73\x61\x67\x65\x20\x3d\x20\x22\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x20\x5b\x63\x6f\x6d\x6d\x61\x6e\x64\x5d\x22\x0a\x09\x61\x70\x70\x2e\x56\x65\x72\x73\x69\x6f\x6e\x20\x3d\x20\x56\x65\x72\x73\x69\x6f\x6e\x0a\x09\x61\x70\x70\x2e\x43\x75\x73\x74\x6f\x6d\x41\x70\x70\x48\x65\x6c\x70\x54\x65\x6d\x70\x6c\x61\x74\x65\x20\x3d\x20\x68\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x0a\x09\x61\x70\x70\x2e\x44\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x20\x3d\x20\x22\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x64\x20\x62\x79\x20\x73\x68\x6f\x67\x75\x6e\x22\x0a\x09\x61\x70\x70\x2e\x41\x63\x74\x69\x6f\x6e\x20\x3d\x20\x6d\x61\x69\x6e\x41\x63\x74\x69\x6f\x6e\x0a\x0a\x09\x2f\x2f\x20\x2d\x76\x20\x73\x65\x74\x73\x20\x74\x68\x65\x20\x6c\x6f\x67\x20\x6c\x65\x76\x65\x6c\x2c\x20\x73\x6f\x20\x74\x68\x65\x20\x76\x65\x72\x73\x69\x6f\x6e\x20\x69\x73\x20\x6f\x6e\x6c\x79\x20\x73\x68\x6f\x77\x6e\x20\x77\x69\x74\x68\x20\x2d\x2d\x76\x65\x72\x73\x69\x6f\x6e\x2e\x0a\x09\x63\x6c\x69\x2e\x56\x65\x72\x73\x69\x6f\x6e\x46\x6c\x61\x67\x20\x3d\x20\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x4e\x61\x6d\x65\x3a\x20\x22\x76\x65\x72\x73\x69\x6f\x6e\x22\x2c\x20\x55\x73\x61\x67\x65\x3a\x20\x22\x70\x72\x69\x6e\x74\x20\x74\x68\x65\x20\x76\x65\x72\x73\x69\x6f\x6e\x22\x7d\x0a\x0a\x09\x61\x70\x70\x2e\x46\x6c\x61\x67\x73\x20\x3d\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x74\x2c\x74\x69\x6d\x65\x6f\x75\x74\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x74\x3d\x34\x6d\x20\x74\x6f\x20\x73\x65\x74\x20\x74\x69\x6d\x65\x6f\x75\x74\x20\x66\x6f\x72\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x75\x73\x69\x6e\x67\x20\x63\x6f\x6e\x74\x65\x78\x74\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x67\x72\x61\x63\x65\x22\x2c\x0a\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x22\x31\x30\x73\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x67\x72\x61\x63\x65\x3d\x33\x30\x73\x20\x74\x6f\x20\x73\x65\x74\x20\x68\x6f\x77\x20\x6c\x6f\x6e\x67\x20\x61\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x68\x61\x73\x20\x74\x6f\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x66\x74\x65\x72\x20\x53\x49\x47\x49\x4e\x54\x20\x6f\x72\x20\x53\x49\x47\x54\x45\x52\x4d\x20\x62\x65\x66\x6f\x72\x65\x20\x61\x20\x66\x6f\x72\x63\x65\x64\x20\x65\x78\x69\x74\x2c\x20\x30\x20\x74\x6f\x20\x77\x61\x69\x74\x20\x77\x69\x74\x68\x6f\x75\x74\x20\x6c\x69\x6d\x69\x74\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x70\x61\x6e\x69\x63\x22\x2c\x0a\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x22\x72\x65\x63\x6f\x76\x65\x72\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x70\x61\x6e\x69\x63\x3d\x63\x72\x61\x73\x68\x20\x74\x6f\x20\x6c\x65\x74\x20\x70\x61\x6e\x69\x63\x73\x20\x6f\x66\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x63\x72\x61\x73\x68\x20\x74\x68\x65\x20\x62\x69\x6e\x61\x72\x79\x20\x77\x69\x74\x68\x20\x47\x6f\x27\x73\x20\x74\x72\x61\x63\x65\x20\x66\x6f\x72\x20\x64\x65\x62\x75\x67\x67\x69\x6e\x67\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x65\x72\x72\x6f\x72\x2d\x66\x6f\x72\x6d\x61\x74\x22\x2c\x0a\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x22\x6a\x73\x6f\x6e\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x65\x72\x72\x6f\x72\x2d\x66\x6f\x72\x6d\x61\x74\x3d\x74\x65\x78\x74\x20\x74\x6f\x20\x77\x72\x69\x74\x65\x20\x65\x72\x72\x6f\x72\x73\x20\x61\x73\x20\x74\x65\x78\x74\x20\x69\x6e\x73\x74\x65\x61\x64\x20\x6f\x66\x20\x6a\x73\x6f\x6e\x20\x74\x6f\x20\x73\x74\x64\x65\x72\x72\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x20\x74\x6f\x20\x77\x72\x69\x74\x65\x20\x74\x68\x65\x20\x72\x65\x73\x75\x6c\x74\x2c\x20\x65\x72\x72\x6f\x72\x20\x61\x6e\x64\x20\x64\x75\x72\x61\x74\x69\x6f\x6e\x20\x6f\x66\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x61\x73\x20\x6a\x73\x6f\x6e\x20\x74\x6f\x20\x73\x74\x64\x6f\x75\x74\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x72\x65\x63\x6f\x72\x64\x22\x2c\x0a\x09\x09\x09\x09\x45\x6e\x76\x56\x61\x72\x3a\x20\x22\x53\x48\x4f\x47\x55\x4e\x5f\x52\x45\x43\x4f\x52\x44\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x20\x22\x2d\x72\x65\x63\x6f\x72\x64\x20\x74\x6f\x20\x73\x61\x76\x65\x20\x74\x68\x65\x20\x69\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x20\x69\x6e\x74\x6f\x20\x74\x68\x65\x20\x68\x69\x73\x74\x6f\x72\x79\x20\x6f\x66\x20\x73\x68\x6f\x67\x75\x6e\x20\x68\x69\x73\x74\x6f\x72\x79\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x49\x6e\x74\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x72\x65\x63\x6f\x72\x64\x2d\x6c\x69\x6d\x69\x74\x22\x2c\x0a\x09\x09\x09\x09\x45\x6e\x76\x56\x61\x72\x3a\x20\x22\x53\x48\x4f\x47\x55\x4e\x5f\x52\x45\x43\x4f\x52\x44\x5f\x4c\x49\x4d\x49\x54\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x20\x22\x2d\x72\x65\x63\x6f\x72\x64\x2d\x6c\x69\x6d\x69\x74\x3d\x31\x30\x32\x34\x20\x74\x6f\x20\x73\x65\x74\x20\x68\x6f\x77\x20\x6d\x61\x6e\x79\x20\x62\x79\x74\x65\x73\x20\x6f\x66\x20\x69\x6e\x70\x75\x74\x20\x61\x6e\x64\x20\x6f\x75\x74\x70\x75\x74\x20\x61\x72\x65\x20\x72\x65\x63\x6f\x72\x64\x65\x64\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x72\x65\x63\x6f\x72\x64\x2d\x69\x6e\x70\x75\x74\x22\x2c\x0a\x09\x09\x09\x09\x45\x6e\x76\x56\x61\x72\x3a\x20\x22\x53\x48\x4f\x47\x55\x4e\x5f\x52\x45\x43\x4f\x52\x44\x5f\x49\x4e\x50\x55\x54\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x20\x22\x2d\x72\x65\x63\x6f\x72\x64\x2d\x69\x6e\x70\x75\x74\x20\x74\x6f\x20\x6b\x65\x65\x70\x20\x74\x68\x65\x20\x69\x6e\x70\x75\x74\x20\x77\x69\x74\x68\x69\x6e\x20\x74\x68\x65\x20\x72\x65\x63\x6f\x72\x64\x2c\x20\x6e\x65\x65\x64\x65\x64\x20\x62\x79\x20\x73\x68\x6f\x67\x75\x6e\x20\x72\x65\x70\x6c\x61\x79\x2c\x20\x72\x61\x74\x68\x65\x72\x20\x74\x68\x61\x6e\x20\x6f\x6e\x6c\x79\x20\x69\x74\x73\x20\x64\x69\x67\x65\x73\x74\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x76\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x76\x20\x74\x6f\x20\x6c\x6f\x67\x20\x69\x6e\x66\x6f\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x6f\x66\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x74\x6f\x20\x73\x74\x64\x65\x72\x72\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x76\x76\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x76\x76\x20\x74\x6f\x20\x6c\x6f\x67\x20\x64\x65\x62\x75\x67\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x6f\x66\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x74\x6f\x20\x73\x74\x64\x65\x72\x72\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x6c\x6f\x67\x2d\x6c\x65\x76\x65\x6c\x22\x2c\x0a\x09\x09\x09\x09\x45\x6e\x76\x56\x61\x72\x3a\x20\x22\x53\x48\x4f\x47\x55\x4e\x5f\x4c\x4f\x47\x5f\x4c\x45\x56\x45\x4c\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x20\x22\x2d\x2d\x6c\x6f\x67\x2d\x6c\x65\x76\x65\x6c\x3d\x64\x65\x62\x75\x67\x20\x74\x6f\x20\x73\x65\x74\x20\x74\x68\x65\x20\x6c\x65\x76\x65\x6c\x20\x6f\x66\x20\x6c\x6f\x67\x67\x65\x64\x20\x72\x65\x63\x6f\x72\x64\x73\x2c\x20\x6f\x6e\x65\x20\x6f\x66\x20\x64\x65\x62\x75\x67\x2c\x20\x69\x6e\x66\x6f\x2c\x20\x77\x61\x72\x6e\x20\x6f\x72\x20\x65\x72\x72\x6f\x72\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x6c\x6f\x67\x2d\x66\x6f\x72\x6d\x61\x74\x22\x2c\x0a\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x20\x22\x74\x65\x78\x74\x22\x2c\x0a\x09\x09\x09\x09\x45\x6e\x76\x56\x61\x72\x3a\x20\x22\x53\x48\x4f\x47\x55\x4e\x5f\x4c\x4f\x47\x5f\x46\x4f\x52\x4d\x41\x54\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x20\x22\x2d\x2d\x6c\x6f\x67\x2d\x66\x6f\x72\x6d\x61\x74\x3d\x6a\x73\x6f\x6e\x20\x74\x6f\x20\x6c\x6f\x67\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x6f\x66\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x61\x73\x20\x6a\x73\x6f\x6e\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x2c\x0a\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x20\x22\x61\x75\x74\x6f\x22\x2c\x0a\x09\x09\x09\x09\x45\x6e\x76\x56\x61\x72\x3a\x20\x22\x53\x48\x4f\x47\x55\x4e\x5f\x50\x52\x4f\x47\x52\x45\x53\x53\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x20\x22\x2d\x2d\x70\x72\x6f\x67\x72\x65\x73\x73\x3d\x6a\x73\x6f\x6e\x20\x74\x6f\x20\x77\x72\x69\x74\x65\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x20\x6f\x66\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x61\x73\x20\x6a\x73\x6f\x6e\x20\x65\x76\x65\x6e\x74\x73\x20\x74\x6f\x20\x73\x74\x64\x65\x72\x72\x2c\x20\x6f\x6e\x65\x20\x6f\x66\x20\x61\x75\x74\x6f\x2c\x20\x74\x74\x79\x2c\x20\x6a\x73\x6f\x6e\x20\x6f\x72\x20\x6e\x6f\x6e\x65\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x74\x72\x61\x63\x65\x22\x2c\x0a\x09\x09\x09\x09\x45\x6e\x76\x56\x61\x72\x3a\x20\x22\x53\x48\x4f\x47\x55\x4e\x5f\x54\x52\x41\x43\x45\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x20\x22\x2d\x2d\x74\x72\x61\x63\x65\x3d\x74\x72\x61\x63\x65\x2e\x6a\x73\x6f\x6e\x20\x74\x6f\x20\x77\x72\x69\x74\x65\x20\x74\x69\x6d\x69\x6e\x67\x20\x73\x70\x61\x6e\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x69\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x20\x69\x6e\x74\x6f\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x74\x72\x61\x63\x65\x2d\x66\x6f\x72\x6d\x61\x74\x22\x2c\x0a\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x20\x22\x6a\x73\x6f\x6e\x22\x2c\x0a\x09\x09\x09\x09\x45\x6e\x76\x56\x61\x72\x3a\x20\x22\x53\x48\x4f\x47\x55\x4e\x5f\x54\x52\x41\x43\x45\x5f\x46\x4f\x52\x4d\x41\x54\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x20\x22\x2d\x2d\x74\x72\x61\x63\x65\x2d\x66\x6f\x72\x6d\x61\x74\x3d\x63\x68\x72\x6f\x6d\x65\x20\x74\x6f\x20\x77\x72\x69\x74\x65\x20\x73\x70\x61\x6e\x73\x20\x61\x73\x20\x74\x72\x61\x63\x65\x20\x65\x76\x65\x6e\x74\x73\x20\x66\x6f\x72\x20\x63\x68\x72\x6f\x6d\x65\x3a\x2f\x2f\x74\x72\x61\x63\x69\x6e\x67\x20\x6f\x72\x20\x50\x65\x72\x66\x65\x74\x74\x6f\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x66\x63\x67\x69\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x66\x63\x67\x69\x20\x74\x6f\x20\x73\x65\x72\x76\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x61\x73\x20\x46\x61\x73\x74\x43\x47\x49\x20\x72\x65\x73\x70\x6f\x6e\x64\x65\x72\x20\x6f\x6e\x20\x74\x68\x65\x20\x73\x6f\x63\x6b\x65\x74\x20\x70\x61\x73\x73\x65\x64\x20\x61\x73\x20\x73\x74\x64\x69\x6e\x20\x62\x79\x20\x74\x68\x65\x20\x77\x65\x62\x20\x73\x65\x72\x76\x65\x72\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x66\x63\x67\x69\x2d\x61\x64\x64\x72\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x66\x63\x67\x69\x2d\x61\x64\x64\x72\x3d\x31\x32\x37\x2e\x30\x2e\x30\x2e\x31\x3a\x39\x30\x30\x30\x20\x74\x6f\x20\x73\x65\x72\x76\x65\x20\x46\x61\x73\x74\x43\x47\x49\x20\x6f\x6e\x20\x67\x69\x76\x69\x6e\x67\x20\x54\x43\x50\x20\x61\x64\x64\x72\x65\x73\x73\x20\x6f\x72\x20\x55\x6e\x69\x78\x20\x73\x6f\x63\x6b\x65\x74\x20\x70\x61\x74\x68\x20\x69\x6e\x73\x74\x65\x61\x64\x20\x6f\x66\x20\x73\x74\x64\x69\x6e\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x66\x63\x67\x69\x2d\x70\x72\x65\x66\x69\x78\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x66\x63\x67\x69\x2d\x70\x72\x65\x66\x69\x78\x3d\x2f\x61\x70\x69\x20\x74\x6f\x20\x73\x74\x72\x69\x70\x20\x67\x69\x76\x69\x6e\x67\x20\x70\x72\x65\x66\x69\x78\x20\x66\x72\x6f\x6d\x20\x72\x65\x71\x75\x65\x73\x74\x20\x70\x61\x74\x68\x73\x20\x62\x65\x66\x6f\x72\x65\x20\x74\x68\x65\x79\x20\x61\x72\x65\x20\x6d\x61\x74\x63\x68\x65\x64\x20\x74\x6f\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x62\x61\x74\x63\x68\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x62\x61\x74\x63\x68\x20\x74\x6f\x20\x63\x61\x6c\x6c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x6f\x72\x20\x65\x76\x65\x72\x79\x20\x6c\x69\x6e\x65\x20\x6f\x66\x20\x4e\x44\x4a\x53\x4f\x4e\x20\x72\x65\x61\x64\x20\x66\x72\x6f\x6d\x20\x73\x74\x64\x69\x6e\x2c\x20\x77\x72\x69\x74\x69\x6e\x67\x20\x61\x20\x4a\x53\x4f\x4e\x20\x6c\x69\x6e\x65\x20\x66\x6f\x72\x20\x65\x61\x63\x68\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x49\x6e\x74\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x77\x6f\x72\x6b\x65\x72\x73\x22\x2c\x0a\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x31\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x77\x6f\x72\x6b\x65\x72\x73\x3d\x38\x20\x74\x6f\x20\x73\x65\x74\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x63\x61\x6c\x6c\x73\x20\x72\x75\x6e\x6e\x69\x6e\x67\x20\x61\x74\x20\x6f\x6e\x63\x65\x20\x77\x69\x74\x68\x20\x2d\x2d\x62\x61\x74\x63\x68\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x75\x6e\x6f\x72\x64\x65\x72\x65\x64\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x75\x6e\x6f\x72\x64\x65\x72\x65\x64\x20\x74\x6f\x20\x77\x72\x69\x74\x65\x20\x6c\x69\x6e\x65\x73\x20\x6f\x66\x20\x2d\x2d\x62\x61\x74\x63\x68\x20\x61\x73\x20\x63\x61\x6c\x6c\x73\x20\x65\x6e\x64\x20\x72\x61\x74\x68\x65\x72\x20\x74\x68\x61\x6e\x20\x69\x6e\x20\x6f\x72\x64\x65\x72\x20\x6f\x66\x20\x69\x6e\x70\x75\x74\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x66\x61\x69\x6c\x2d\x66\x61\x73\x74\x22\x2c\x0a\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x66\x61\x69\x6c\x2d\x66\x61\x73\x74\x20\x74\x6f\x20\x73\x74\x6f\x70\x20\x72\x65\x61\x64\x69\x6e\x67\x20\x69\x6e\x70\x75\x74\x20\x6f\x66\x20\x2d\x2d\x62\x61\x74\x63\x68\x20\x61\x66\x74\x65\x72\x20\x74\x68\x65\x20\x66\x69\x72\x73\x74\x20\x66\x61\x69\x6c\x65\x64\x20\x63\x61\x6c\x6c\x22\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x7d\x0a\x0a\x09\x61\x70\x70\x2e\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x3d\x20\x5b\x5d\x63\x6c\x69\x2e\x43\x6f\x6d\x6d\x61\x6e\x64\x7b\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x63\x67\x69\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x63\x67\x69\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x09\x48\x69\x64\x64\x65\x6e\x3a\x20\x74\x72\x75\x65\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x68\x65\x6c\x70\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x68\x65\x6c\x70\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x09\x46\x6c\x61\x67\x73\x3a\x20\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x73\x2c\x73\x6f\x75\x72\x63\x65\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x73\x6f\x75\x72\x63\x65\x20\x74\x6f\x20\x73\x68\x6f\x77\x20\x73\x6f\x75\x72\x63\x65\x20\x6f\x66\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x61\x73\x20\x77\x65\x6c\x6c\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x77\x61\x74\x63\x68\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x77\x61\x74\x63\x68\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x73\x65\x72\x76\x65\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x73\x65\x72\x76\x65\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x09\x46\x6c\x61\x67\x73\x3a\x20\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x61\x64\x64\x72\x22\x2c\x0a\x09\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x22\x3a\x38\x30\x38\x30\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x61\x64\x64\x72\x3d\x3a\x38\x30\x38\x30\x20\x74\x6f\x20\x73\x65\x74\x20\x61\x64\x64\x72\x65\x73\x73\x20\x74\x6f\x20\x6c\x69\x73\x74\x65\x6e\x20\x6f\x6e\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x74\x6f\x6b\x65\x6e\x22\x2c\x0a\x09\x09\x09\x09\x09\x45\x6e\x76\x56\x61\x72\x3a\x20\x22\x53\x48\x4f\x47\x55\x4e\x5f\x53\x45\x52\x56\x45\x5f\x54\x4f\x4b\x45\x4e\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x20\x22\x2d\x2d\x74\x6f\x6b\x65\x6e\x3d\x73\x65\x63\x72\x65\x74\x20\x74\x6f\x20\x72\x65\x71\x75\x69\x72\x65\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x74\x6f\x20\x63\x61\x72\x72\x79\x20\x61\x20\x62\x65\x61\x72\x65\x72\x20\x74\x6f\x6b\x65\x6e\x20\x69\x6e\x20\x74\x68\x65\x69\x72\x20\x41\x75\x74\x68\x6f\x72\x69\x7a\x61\x74\x69\x6f\x6e\x20\x68\x65\x61\x64\x65\x72\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x74\x6c\x73\x2d\x63\x65\x72\x74\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x74\x6c\x73\x2d\x63\x65\x72\x74\x3d\x2e\x2f\x63\x65\x72\x74\x2e\x70\x65\x6d\x20\x74\x6f\x20\x73\x65\x72\x76\x65\x20\x6f\x76\x65\x72\x20\x54\x4c\x53\x20\x77\x69\x74\x68\x20\x67\x69\x76\x69\x6e\x67\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x2c\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x2d\x2d\x74\x6c\x73\x2d\x6b\x65\x79\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x74\x6c\x73\x2d\x6b\x65\x79\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x74\x6c\x73\x2d\x6b\x65\x79\x3d\x2e\x2f\x6b\x65\x79\x2e\x70\x65\x6d\x20\x74\x6f\x20\x73\x65\x74\x20\x70\x72\x69\x76\x61\x74\x65\x20\x6b\x65\x79\x20\x6f\x66\x20\x2d\x2d\x74\x6c\x73\x2d\x63\x65\x72\x74\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x49\x6e\x74\x36\x34\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x6d\x61\x78\x2d\x62\x6f\x64\x79\x22\x2c\x0a\x09\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x73\x65\x72\x76\x65\x2e\x44\x65\x66\x61\x75\x6c\x74\x4d\x61\x78\x42\x6f\x64\x79\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x6d\x61\x78\x2d\x62\x6f\x64\x79\x3d\x31\x30\x34\x38\x35\x37\x36\x20\x74\x6f\x20\x73\x65\x74\x20\x6d\x61\x78\x69\x6d\x75\x6d\x20\x62\x79\x74\x65\x73\x20\x6f\x66\x20\x72\x65\x71\x75\x65\x73\x74\x20\x62\x6f\x64\x69\x65\x73\x2c\x20\x30\x20\x66\x6f\x72\x20\x6e\x6f\x20\x6c\x69\x6d\x69\x74\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x49\x6e\x74\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x63\x6f\x6e\x63\x75\x72\x72\x65\x6e\x63\x79\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x63\x6f\x6e\x63\x75\x72\x72\x65\x6e\x63\x79\x3d\x38\x20\x74\x6f\x20\x73\x65\x74\x20\x6d\x61\x78\x69\x6d\x75\x6d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x72\x75\x6e\x6e\x69\x6e\x67\x20\x61\x74\x20\x6f\x6e\x63\x65\x2c\x20\x30\x20\x66\x6f\x72\x20\x6e\x6f\x20\x6c\x69\x6d\x69\x74\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x75\x69\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x75\x69\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x09\x46\x6c\x61\x67\x73\x3a\x20\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x61\x64\x64\x72\x22\x2c\x0a\x09\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x22\x31\x32\x37\x2e\x30\x2e\x30\x2e\x31\x3a\x37\x30\x37\x30\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x61\x64\x64\x72\x3d\x31\x32\x37\x2e\x30\x2e\x30\x2e\x31\x3a\x37\x30\x37\x30\x20\x74\x6f\x20\x73\x65\x74\x20\x61\x64\x64\x72\x65\x73\x73\x20\x74\x68\x65\x20\x77\x65\x62\x20\x55\x49\x20\x6c\x69\x73\x74\x65\x6e\x73\x20\x6f\x6e\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x6d\x63\x70\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x6d\x63\x70\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x6c\x61\x6d\x62\x64\x61\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x6c\x61\x6d\x62\x64\x61\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x09\x46\x6c\x61\x67\x73\x3a\x20\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x68\x61\x6e\x64\x6c\x65\x72\x22\x2c\x0a\x09\x09\x09\x09\x09\x45\x6e\x76\x56\x61\x72\x3a\x20\x22\x5f\x48\x41\x4e\x44\x4c\x45\x52\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x20\x22\x2d\x2d\x68\x61\x6e\x64\x6c\x65\x72\x3d\x67\x72\x65\x65\x74\x20\x6f\x72\x20\x2d\x2d\x68\x61\x6e\x64\x6c\x65\x72\x3d\x73\x75\x62\x2f\x77\x6f\x72\x64\x73\x20\x74\x6f\x20\x73\x65\x74\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x68\x61\x6e\x64\x6c\x69\x6e\x67\x20\x69\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x73\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x77\x6f\x72\x6b\x65\x72\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x77\x6f\x72\x6b\x65\x72\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x09\x46\x6c\x61\x67\x73\x3a\x20\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x66\x6e\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x66\x6e\x3d\x67\x72\x65\x65\x74\x20\x6f\x72\x20\x2d\x2d\x66\x6e\x3d\x73\x75\x62\x2f\x77\x6f\x72\x64\x73\x20\x74\x6f\x20\x73\x65\x74\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x61\x6c\x6c\x65\x64\x20\x77\x69\x74\x68\x20\x65\x61\x63\x68\x20\x66\x69\x6c\x65\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x69\x6e\x62\x6f\x78\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x69\x6e\x62\x6f\x78\x3d\x2e\x2f\x69\x6e\x62\x6f\x78\x20\x74\x6f\x20\x73\x65\x74\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x66\x69\x6c\x65\x73\x20\x61\x72\x65\x20\x63\x6c\x61\x69\x6d\x65\x64\x20\x66\x72\x6f\x6d\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x6f\x75\x74\x62\x6f\x78\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x6f\x75\x74\x62\x6f\x78\x3d\x2e\x2f\x6f\x75\x74\x62\x6f\x78\x20\x74\x6f\x20\x73\x65\x74\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x6f\x75\x74\x70\x75\x74\x20\x69\x73\x20\x77\x72\x69\x74\x74\x65\x6e\x20\x69\x6e\x74\x6f\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x66\x61\x69\x6c\x65\x64\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x66\x61\x69\x6c\x65\x64\x3d\x2e\x2f\x66\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x73\x65\x74\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x66\x61\x69\x6c\x65\x64\x20\x66\x69\x6c\x65\x73\x20\x61\x72\x65\x20\x6d\x6f\x76\x65\x64\x20\x69\x6e\x74\x6f\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x49\x6e\x74\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x63\x6f\x6e\x63\x75\x72\x72\x65\x6e\x63\x79\x22\x2c\x0a\x09\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x31\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x63\x6f\x6e\x63\x75\x72\x72\x65\x6e\x63\x79\x3d\x34\x20\x74\x6f\x20\x73\x65\x74\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x66\x69\x6c\x65\x73\x20\x70\x72\x6f\x63\x65\x73\x73\x65\x64\x20\x61\x74\x20\x6f\x6e\x63\x65\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x49\x6e\x74\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x61\x74\x74\x65\x6d\x70\x74\x73\x22\x2c\x0a\x09\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x33\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x61\x74\x74\x65\x6d\x70\x74\x73\x3d\x33\x20\x74\x6f\x20\x73\x65\x74\x20\x6e\x75\x6d\x62\x65\x72\x20\x6f\x66\x20\x61\x74\x74\x65\x6d\x70\x74\x73\x20\x6f\x66\x20\x66\x69\x6c\x65\x73\x20\x66\x61\x69\x6c\x69\x6e\x67\x20\x77\x69\x74\x68\x20\x72\x65\x74\x72\x79\x61\x62\x6c\x65\x20\x65\x72\x72\x6f\x72\x73\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x62\x61\x63\x6b\x6f\x66\x66\x22\x2c\x0a\x09\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x22\x31\x73\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x62\x61\x63\x6b\x6f\x66\x66\x3d\x31\x73\x20\x74\x6f\x20\x73\x65\x74\x20\x69\x6e\x69\x74\x69\x61\x6c\x20\x64\x65\x6c\x61\x79\x20\x62\x65\x74\x77\x65\x65\x6e\x20\x61\x74\x74\x65\x6d\x70\x74\x73\x2c\x20\x64\x6f\x75\x62\x6c\x65\x64\x20\x61\x66\x74\x65\x72\x20\x65\x61\x63\x68\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x70\x6f\x6c\x6c\x22\x2c\x0a\x09\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x22\x31\x73\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x70\x6f\x6c\x6c\x3d\x31\x73\x20\x74\x6f\x20\x73\x65\x74\x20\x68\x6f\x77\x20\x6f\x66\x74\x65\x6e\x20\x61\x6e\x20\x65\x6d\x70\x74\x79\x20\x69\x6e\x62\x6f\x78\x20\x69\x73\x20\x72\x65\x61\x64\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x64\x61\x65\x6d\x6f\x6e\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x64\x61\x65\x6d\x6f\x6e\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x09\x46\x6c\x61\x67\x73\x3a\x20\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x73\x6f\x63\x6b\x65\x74\x22\x2c\x0a\x09\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x64\x61\x65\x6d\x6f\x6e\x2e\x53\x6f\x63\x6b\x65\x74\x50\x61\x74\x68\x28\x62\x69\x6e\x4e\x61\x6d\x65\x29\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x2d\x73\x6f\x63\x6b\x65\x74\x3d\x2e\x2f\x64\x65\x6d\x6f\x2e\x73\x6f\x63\x6b\x20\x74\x6f\x20\x73\x65\x74\x20\x70\x61\x74\x68\x20\x6f\x66\x20\x55\x6e\x69\x78\x20\x73\x6f\x63\x6b\x65\x74\x20\x74\x6f\x20\x6c\x69\x73\x74\x65\x6e\x20\x6f\x6e\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x73\x63\x68\x65\x64\x75\x6c\x65\x22\x2c\x0a\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x73\x63\x68\x65\x64\x75\x6c\x65\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x09\x46\x6c\x61\x67\x73\x3a\x20\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x74\x7a\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x74\x7a\x3d\x45\x75\x72\x6f\x70\x65\x2f\x42\x65\x72\x6c\x69\x6e\x20\x74\x6f\x20\x73\x65\x74\x20\x74\x69\x6d\x65\x20\x7a\x6f\x6e\x65\x20\x66\x6f\x72\x20\x40\x63\x72\x6f\x6e\x20\x61\x6e\x6e\x6f\x74\x61\x74\x69\x6f\x6e\x73\x20\x77\x69\x74\x68\x6f\x75\x74\x20\x6f\x6e\x65\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x6a\x69\x74\x74\x65\x72\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x6a\x69\x74\x74\x65\x72\x3d\x33\x30\x73\x20\x74\x6f\x20\x64\x65\x6c\x61\x79\x20\x65\x61\x63\x68\x20\x72\x75\x6e\x20\x62\x79\x20\x61\x20\x72\x61\x6e\x64\x6f\x6d\x20\x64\x75\x72\x61\x74\x69\x6f\x6e\x20\x75\x70\x20\x74\x6f\x20\x67\x69\x76\x69\x6e\x67\x20\x76\x61\x6c\x75\x65\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x6f\x76\x65\x72\x6c\x61\x70\x22\x2c\x0a\x09\x09\x09\x09\x09\x56\x61\x6c\x75\x65\x3a\x20\x73\x63\x68\x65\x64\x75\x6c\x65\x2e\x53\x6b\x69\x70\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x6f\x76\x65\x72\x6c\x61\x70\x3d\x71\x75\x65\x75\x65\x20\x74\x6f\x20\x73\x65\x74\x20\x77\x68\x61\x74\x20\x68\x61\x70\x70\x65\x6e\x73\x20\x77\x68\x65\x6e\x20\x61\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x69\x73\x20\x64\x75\x65\x20\x77\x68\x69\x6c\x65\x20\x72\x75\x6e\x6e\x69\x6e\x67\x3a\x20\x73\x6b\x69\x70\x2c\x20\x71\x75\x65\x75\x65\x20\x6f\x72\x20\x61\x6c\x6c\x6f\x77\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x6c\x6f\x67\x22\x2c\x0a\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x6c\x6f\x67\x3d\x2e\x2f\x72\x75\x6e\x73\x2e\x6c\x6f\x67\x20\x74\x6f\x20\x61\x70\x70\x65\x6e\x64\x20\x72\x75\x6e\x20\x72\x65\x73\x75\x6c\x74\x73\x20\x61\x73\x20\x4a\x53\x4f\x4e\x20\x6c\x69\x6e\x65\x73\x20\x69\x6e\x74\x6f\x20\x66\x69\x6c\x65\x20\x69\x6e\x73\x74\x65\x61\x64\x20\x6f\x66\x20\x73\x74\x64\x6f\x75\x74\x22\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x09\x7b\x0a\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x22\x66\x6c\x6f\x77\x22\x2c\x0a\x09\x09\x09\x53\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x3a\x20\x5b\x5d\x63\x6c\x69\x2e\x43\x6f\x6d\x6d\x61\x6e\x64\x7b\x0a\x09\x09\x09\x09\x7b\x0a\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x20\x22\x72\x75\x6e\x22\x2c\x0a\x09\x09\x09\x09\x09\x41\x63\x74\x69\x6f\x6e\x3a\x20\x66\x6c\x6f\x77\x52\x75\x6e\x41\x63\x74\x69\x6f\x6e\x2c\x0a\x09\x09\x09\x09\x09\x46\x6c\x61\x67\x73\x3a\x20\x20\x5b\x5d\x63\x6c\x69\x2e\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x09\x63\x6c\x69\x2e\x42\x6f\x6f\x6c\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x72\x65\x73\x75\x6d\x65\x22\x2c\x0a\x09\x09\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x72\x65\x73\x75\x6d\x65\x20\x74\x6f\x20\x63\x6f\x6e\x74\x69\x6e\x75\x65\x20\x61\x20\x66\x61\x69\x6c\x65\x64\x20\x66\x6c\x6f\x77\x20\x66\x72\x6f\x6d\x20\x69\x74\x73\x20\x66\x61\x69\x6c\x65\x64\x20\x73\x74\x65\x70\x22\x2c\x0a\x09\x09\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x73\x74\x61\x74\x65\x22\x2c\x0a\x09\x09\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x73\x74\x61\x74\x65\x3d\x2e\x2f\x64\x65\x70\x6c\x6f\x79\x2e\x73\x74\x61\x74\x65\x2e\x6a\x73\x6f\x6e\x20\x74\x6f\x20\x73\x65\x74\x20\x66\x69\x6c\x65\x20\x77\x68\x65\x72\x65\x20\x73\x74\x65\x70\x20\x72\x65\x73\x75\x6c\x74\x73\x20\x61\x72\x65\x20\x73\x61\x76\x65\x64\x22\x2c\x0a\x09\x09\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x09\x09\x63\x6c\x69\x2e\x53\x74\x72\x69\x6e\x67\x46\x6c\x61\x67\x7b\x0a\x09\x09\x09\x09\x09\x09\x09\x4e\x61\x6d\x65\x3a\x20\x20\x22\x62\x69\x6e\x2c\x62\x69\x6e\x44\x69\x72\x22\x2c\x0a\x09\x09\x09\x09\x09\x09\x09\x55\x73\x61\x67\x65\x3a\x20\x22\x2d\x62\x69\x6e\x3d\x2e\x2f\x20\x74\x6f\x20\x73\x65\x74\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x77\x68\x65\x72\x65\x20\x6f\x74\x68\x65\x72\x20\x62\x69\x6e\x61\x72\x69\x65\x73\x20\x61\x72\x65\x20\x6c\x6f\x63\x61\x74\x65\x64\x22\x2c\x0a\x09\x09\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x09\x7d\x2c\x0a\x09\x09\x09\x7d\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x7d\x0a\x0a\x09\x2f\x2f\x20\x52\x75\x6e\x20\x62\x79\x20\x61\x20\x77\x65\x62\x20\x73\x65\x72\x76\x65\x72\x20\x61\x73\x20\x61\x20\x43\x47\x49\x20\x73\x63\x72\x69\x70\x74\x2c\x20\x74\x68\x65\x20\x72\x65\x71\x75\x65\x73\x74\x20\x69\x73\x20\x73\x65\x72\x76\x65\x64\x20\x69\x6e\x73\x74\x65\x61\x64\x20\x6f\x66\x20\x61\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x2e\x0a\x09\x69\x66\x20\x73\x65\x72\x76\x65\x2e\x49\x73\x43\x47\x49\x28\x6f\x73\x2e\x41\x72\x67\x73\x5b\x31\x3a\x5d\x29\x20\x7b\x0a\x09\x09\x6f\x73\x2e\x41\x72\x67\x73\x20\x3d\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x6f\x73\x2e\x41\x72\x67\x73\x5b\x30\x5d\x2c\x20\x22\x63\x67\x69\x22\x7d\x0a\x09\x7d\x0a\x0a\x09\x61\x70\x70\x2e\x52\x75\x6e\x41\x6e\x64\x45\x78\x69\x74\x4f\x6e\x45\x72\x72\x6f\x72\x28\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x68\x65\x6c\x70\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x69\x66\x20\x63\x2e\x4e\x41\x72\x67\x28\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x20\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x6c\x6e\x28\x68\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x29\x0a\x09\x09\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x48\x65\x6c\x70\x28\x0a\x09\x09\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x73\x6f\x75\x72\x63\x65\x22\x29\x2c\x0a\x09\x09\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x46\x69\x72\x73\x74\x28\x29\x2c\x0a\x09\x09\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x54\x61\x69\x6c\x28\x29\x2c\x0a\x09\x09\x6f\x73\x2e\x53\x74\x64\x69\x6e\x2c\x0a\x09\x09\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x7d\x2c\x0a\x09\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x6d\x61\x69\x6e\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x69\x66\x20\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x66\x63\x67\x69\x22\x29\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x63\x67\x69\x41\x63\x74\x69\x6f\x6e\x28\x63\x29\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x62\x61\x74\x63\x68\x22\x29\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x62\x61\x74\x63\x68\x41\x63\x74\x69\x6f\x6e\x28\x63\x29\x0a\x09\x7d\x0a\x0a\x09\x69\x6e\x70\x75\x74\x20\x3a\x3d\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x28\x6f\x73\x2e\x53\x74\x64\x69\x6e\x29\x0a\x09\x6f\x75\x74\x70\x75\x74\x20\x3a\x3d\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x28\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x29\x0a\x0a\x09\x66\x6c\x61\x67\x73\x2c\x20\x5f\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x69\x6c\x74\x65\x72\x46\x6c\x61\x67\x73\x28\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x54\x61\x69\x6c\x28\x29\x29\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x6e\x61\x6d\x65\x2c\x20\x61\x72\x67\x73\x20\x3a\x3d\x20\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x46\x69\x72\x73\x74\x28\x29\x2c\x20\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x54\x61\x69\x6c\x28\x29\x0a\x0a\x09\x2f\x2f\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x72\x65\x61\x64\x69\x6e\x67\x20\x4a\x53\x4f\x4e\x20\x74\x61\x6b\x65\x20\x60\x6b\x65\x79\x3d\x76\x61\x6c\x75\x65\x60\x20\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x20\x6d\x65\x72\x67\x65\x64\x20\x69\x6e\x74\x6f\x20\x4a\x53\x4f\x4e\x20\x72\x65\x61\x64\x20\x66\x72\x6f\x6d\x20\x73\x74\x64\x69\x6e\x2e\x0a\x09\x76\x61\x72\x20\x69\x6e\x70\x75\x74\x45\x72\x72\x20\x65\x72\x72\x6f\x72\x0a\x09\x69\x66\x20\x6d\x65\x74\x61\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x46\x69\x72\x73\x74\x28\x29\x2c\x20\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x54\x61\x69\x6c\x28\x29\x29\x3b\x20\x65\x72\x72\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x6e\x61\x6d\x65\x20\x3d\x20\x6d\x65\x74\x61\x2e\x4e\x61\x6d\x65\x0a\x0a\x09\x09\x69\x66\x20\x61\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x2c\x20\x72\x65\x73\x74\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x41\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x28\x61\x72\x67\x73\x29\x3b\x20\x6c\x65\x6e\x28\x61\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x29\x20\x21\x3d\x20\x30\x20\x26\x26\x20\x74\x61\x6b\x65\x73\x4a\x53\x4f\x4e\x28\x6d\x65\x74\x61\x29\x20\x7b\x0a\x09\x09\x09\x61\x72\x67\x73\x20\x3d\x20\x72\x65\x73\x74\x0a\x09\x09\x09\x69\x6e\x70\x75\x74\x2c\x20\x69\x6e\x70\x75\x74\x45\x72\x72\x20\x3d\x20\x61\x73\x73\x69\x67\x6e\x65\x64\x49\x6e\x70\x75\x74\x28\x69\x6e\x70\x75\x74\x2c\x20\x61\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x29\x0a\x09\x09\x7d\x0a\x09\x7d\x0a\x0a\x09\x2f\x2f\x20\x57\x69\x74\x68\x20\x61\x6e\x20\x65\x6e\x76\x65\x6c\x6f\x70\x65\x2c\x20\x6f\x75\x74\x70\x75\x74\x20\x69\x73\x20\x63\x6f\x6c\x6c\x65\x63\x74\x65\x64\x20\x74\x6f\x20\x62\x65\x20\x77\x72\x69\x74\x74\x65\x6e\x20\x61\x73\x20\x69\x74\x73\x20\x72\x65\x73\x75\x6c\x74\x2e\x0a\x09\x76\x61\x72\x20\x72\x65\x73\x75\x6c\x74\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x09\x69\x66\x20\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x29\x20\x7b\x0a\x09\x09\x6f\x75\x74\x70\x75\x74\x20\x3d\x20\x26\x72\x65\x73\x75\x6c\x74\x0a\x09\x7d\x0a\x0a\x0a\x09\x67\x72\x61\x63\x65\x2c\x20\x67\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x67\x72\x61\x63\x65\x22\x29\x29\x0a\x09\x69\x66\x20\x67\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x49\x6e\x76\x61\x6c\x69\x64\x20\x67\x72\x61\x63\x65\x20\x64\x75\x72\x61\x74\x69\x6f\x6e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x67\x65\x72\x72\x29\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x0a\x09\x2f\x2f\x20\x52\x65\x63\x6f\x72\x64\x65\x64\x20\x69\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x73\x20\x73\x68\x61\x72\x65\x20\x74\x68\x65\x69\x72\x20\x49\x44\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x27\x73\x20\x6c\x6f\x67\x67\x65\x72\x2e\x0a\x09\x76\x61\x72\x20\x73\x65\x73\x73\x69\x6f\x6e\x20\x2a\x68\x69\x73\x74\x6f\x72\x79\x2e\x53\x65\x73\x73\x69\x6f\x6e\x0a\x09\x69\x66\x20\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x72\x65\x63\x6f\x72\x64\x22\x29\x20\x7b\x0a\x09\x09\x73\x65\x73\x73\x69\x6f\x6e\x20\x3d\x20\x68\x69\x73\x74\x6f\x72\x79\x2e\x42\x65\x67\x69\x6e\x28\x62\x69\x6e\x4e\x61\x6d\x65\x2c\x20\x6e\x61\x6d\x65\x2c\x20\x63\x2e\x41\x72\x67\x73\x28\x29\x2c\x20\x74\x6d\x2c\x20\x63\x2e\x49\x6e\x74\x28\x22\x72\x65\x63\x6f\x72\x64\x2d\x6c\x69\x6d\x69\x74\x22\x29\x2c\x20\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x72\x65\x63\x6f\x72\x64\x2d\x69\x6e\x70\x75\x74\x22\x29\x29\x0a\x09\x09\x69\x6e\x70\x75\x74\x2c\x20\x6f\x75\x74\x70\x75\x74\x20\x3d\x20\x73\x65\x73\x73\x69\x6f\x6e\x2e\x49\x6e\x70\x75\x74\x28\x69\x6e\x70\x75\x74\x29\x2c\x20\x73\x65\x73\x73\x69\x6f\x6e\x2e\x4f\x75\x74\x70\x75\x74\x28\x6f\x75\x74\x70\x75\x74\x29\x0a\x09\x09\x70\x61\x72\x65\x6e\x74\x20\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x69\x74\x68\x49\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x49\x44\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x73\x65\x73\x73\x69\x6f\x6e\x2e\x49\x44\x28\x29\x29\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x70\x61\x6e\x69\x63\x22\x29\x20\x3d\x3d\x20\x22\x63\x72\x61\x73\x68\x22\x20\x7b\x0a\x09\x09\x70\x61\x72\x65\x6e\x74\x20\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x69\x74\x68\x43\x72\x61\x73\x68\x4f\x6e\x50\x61\x6e\x69\x63\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x6e\x64\x54\x72\x61\x63\x65\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x74\x61\x72\x74\x54\x72\x61\x63\x65\x28\x63\x2c\x20\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x65\x6e\x64\x54\x72\x61\x63\x65\x28\x29\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x6e\x64\x50\x72\x6f\x67\x72\x65\x73\x73\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x74\x61\x72\x74\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x63\x2c\x20\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x65\x6e\x64\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x29\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x73\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x6e\x61\x6d\x65\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x09\x2f\x2f\x20\x53\x69\x67\x6e\x61\x6c\x73\x20\x63\x61\x6e\x63\x65\x6c\x20\x74\x68\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x27\x73\x20\x63\x6f\x6e\x74\x65\x78\x74\x2c\x20\x77\x69\x74\x68\x20\x61\x20\x73\x65\x63\x6f\x6e\x64\x20\x73\x69\x67\x6e\x61\x6c\x20\x6f\x72\x20\x74\x68\x65\x20\x65\x6e\x64\x20\x6f\x66\x20\x74\x68\x65\x20\x67\x72\x61\x63\x65\x20\x70\x65\x72\x69\x6f\x64\x20\x66\x6f\x72\x63\x69\x6e\x67\x20\x61\x6e\x20\x65\x78\x69\x74\x2e\x0a\x09\x63\x61\x6e\x63\x65\x6c\x6c\x61\x74\x69\x6f\x6e\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x43\x61\x6e\x63\x65\x6c\x4f\x6e\x53\x69\x67\x6e\x61\x6c\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x67\x72\x61\x63\x65\x2c\x20\x66\x75\x6e\x63\x28\x72\x65\x61\x73\x6f\x6e\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x7b\x0a\x09\x09\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x72\x69\x74\x65\x45\x72\x72\x6f\x72\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x65\x72\x72\x6f\x72\x2d\x66\x6f\x72\x6d\x61\x74\x22\x29\x2c\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x45\x6e\x76\x65\x6c\x6f\x70\x65\x7b\x0a\x09\x09\x09\x43\x6f\x64\x65\x3a\x20\x20\x20\x20\x20\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x43\x6f\x64\x65\x43\x61\x6e\x63\x65\x6c\x6c\x65\x64\x2c\x0a\x09\x09\x09\x4d\x65\x73\x73\x61\x67\x65\x3a\x20\x20\x20\x72\x65\x61\x73\x6f\x6e\x2c\x0a\x09\x09\x09\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x20\x6e\x61\x6d\x65\x2c\x0a\x09\x09\x09\x52\x65\x74\x72\x79\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x2c\x0a\x09\x09\x09\x45\x78\x69\x74\x43\x6f\x64\x65\x3a\x20\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x78\x69\x74\x43\x61\x6e\x63\x65\x6c\x6c\x65\x64\x2c\x0a\x09\x09\x7d\x29\x0a\x0a\x09\x09\x6f\x73\x2e\x45\x78\x69\x74\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x78\x69\x74\x43\x61\x6e\x63\x65\x6c\x6c\x65\x64\x29\x0a\x09\x7d\x29\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x61\x74\x69\x6f\x6e\x2e\x53\x74\x6f\x70\x28\x29\x0a\x0a\x09\x73\x74\x61\x72\x74\x65\x64\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x4e\x6f\x77\x28\x29\x0a\x09\x65\x72\x72\x20\x3d\x20\x69\x6e\x70\x75\x74\x45\x72\x72\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x65\x72\x72\x20\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x0a\x09\x09\x09\x63\x61\x6e\x63\x65\x6c\x6c\x61\x74\x69\x6f\x6e\x2e\x43\x6f\x6e\x74\x65\x78\x74\x28\x29\x2c\x0a\x09\x09\x09\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x46\x69\x72\x73\x74\x28\x29\x2c\x0a\x09\x09\x09\x61\x72\x67\x73\x2c\x0a\x09\x09\x09\x66\x6c\x61\x67\x73\x2c\x0a\x09\x09\x09\x69\x6e\x70\x75\x74\x2c\x0a\x09\x09\x09\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x6f\x75\x74\x70\x75\x74\x7d\x2c\x0a\x09\x09\x09\x74\x6d\x2c\x0a\x09\x09\x29\x0a\x09\x7d\x0a\x0a\x09\x2f\x2f\x20\x41\x20\x72\x75\x6e\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x64\x20\x62\x79\x20\x61\x20\x73\x69\x67\x6e\x61\x6c\x20\x72\x65\x70\x6f\x72\x74\x73\x20\x73\x6f\x2c\x20\x77\x68\x61\x74\x65\x76\x65\x72\x20\x74\x68\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x2e\x0a\x09\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x61\x74\x69\x6f\x6e\x2e\x53\x69\x67\x6e\x61\x6c\x6c\x65\x64\x28\x29\x20\x26\x26\x20\x65\x72\x72\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x65\x72\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x61\x6e\x63\x65\x6c\x65\x64\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3d\x3d\x20\x70\x6b\x67\x2e\x45\x72\x72\x4e\x6f\x44\x65\x66\x61\x75\x6c\x74\x20\x7b\x0a\x09\x09\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x6c\x6e\x28\x68\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x7d\x0a\x0a\x09\x76\x61\x72\x20\x65\x6e\x76\x65\x6c\x6f\x70\x65\x20\x2a\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x45\x6e\x76\x65\x6c\x6f\x70\x65\x0a\x09\x65\x78\x69\x74\x43\x6f\x64\x65\x20\x3a\x3d\x20\x30\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x66\x61\x69\x6c\x75\x72\x65\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x65\x77\x45\x72\x72\x6f\x72\x45\x6e\x76\x65\x6c\x6f\x70\x65\x28\x6e\x61\x6d\x65\x2c\x20\x65\x72\x72\x29\x0a\x09\x09\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x61\x74\x69\x6f\x6e\x2e\x53\x69\x67\x6e\x61\x6c\x6c\x65\x64\x28\x29\x20\x7b\x0a\x09\x09\x09\x66\x61\x69\x6c\x75\x72\x65\x2e\x43\x6f\x64\x65\x2c\x20\x66\x61\x69\x6c\x75\x72\x65\x2e\x45\x78\x69\x74\x43\x6f\x64\x65\x20\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x43\x6f\x64\x65\x43\x61\x6e\x63\x65\x6c\x6c\x65\x64\x2c\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x78\x69\x74\x43\x61\x6e\x63\x65\x6c\x6c\x65\x64\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x65\x6e\x76\x65\x6c\x6f\x70\x65\x2c\x20\x65\x78\x69\x74\x43\x6f\x64\x65\x20\x3d\x20\x26\x66\x61\x69\x6c\x75\x72\x65\x2c\x20\x66\x61\x69\x6c\x75\x72\x65\x2e\x45\x78\x69\x74\x43\x6f\x64\x65\x0a\x09\x7d\x0a\x0a\x09\x73\x70\x61\x6e\x2e\x46\x61\x69\x6c\x28\x65\x72\x72\x29\x0a\x0a\x09\x5f\x2c\x20\x6f\x75\x74\x70\x75\x74\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x6f\x75\x74\x70\x75\x74\x22\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x6f\x75\x74\x70\x75\x74\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x09\x69\x66\x20\x73\x65\x73\x73\x69\x6f\x6e\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x69\x66\x20\x72\x65\x63\x6f\x72\x64\x45\x72\x72\x20\x3a\x3d\x20\x73\x65\x73\x73\x69\x6f\x6e\x2e\x45\x6e\x64\x28\x65\x78\x69\x74\x43\x6f\x64\x65\x2c\x20\x65\x6e\x76\x65\x6c\x6f\x70\x65\x29\x3b\x20\x72\x65\x63\x6f\x72\x64\x45\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa1\xbf\x20\x46\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x72\x65\x63\x6f\x72\x64\x20\x69\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x3a\x20\x25\x73\x5c\x6e\x22\x2c\x20\x72\x65\x63\x6f\x72\x64\x45\x72\x72\x29\x0a\x09\x09\x7d\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x29\x20\x7b\x0a\x09\x09\x69\x66\x20\x6a\x73\x6f\x6e\x45\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x45\x6e\x63\x6f\x64\x65\x72\x28\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x29\x2e\x45\x6e\x63\x6f\x64\x65\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x65\x77\x52\x65\x73\x75\x6c\x74\x45\x6e\x76\x65\x6c\x6f\x70\x65\x28\x6e\x61\x6d\x65\x2c\x20\x72\x65\x73\x75\x6c\x74\x2e\x42\x79\x74\x65\x73\x28\x29\x2c\x20\x65\x6e\x76\x65\x6c\x6f\x70\x65\x2c\x20\x74\x69\x6d\x65\x2e\x53\x69\x6e\x63\x65\x28\x73\x74\x61\x72\x74\x65\x64\x29\x29\x29\x3b\x20\x6a\x73\x6f\x6e\x45\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6a\x73\x6f\x6e\x45\x72\x72\x0a\x09\x09\x7d\x0a\x09\x7d\x20\x65\x6c\x73\x65\x20\x69\x66\x20\x65\x6e\x76\x65\x6c\x6f\x70\x65\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x69\x66\x20\x66\x6f\x72\x6d\x61\x74\x45\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x72\x69\x74\x65\x45\x72\x72\x6f\x72\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x65\x72\x72\x6f\x72\x2d\x66\x6f\x72\x6d\x61\x74\x22\x29\x2c\x20\x2a\x65\x6e\x76\x65\x6c\x6f\x70\x65\x29\x3b\x20\x66\x6f\x72\x6d\x61\x74\x45\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x6f\x72\x6d\x61\x74\x45\x72\x72\x0a\x09\x09\x7d\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x65\x6e\x76\x65\x6c\x6f\x70\x65\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x63\x6c\x69\x2e\x4e\x65\x77\x45\x78\x69\x74\x45\x72\x72\x6f\x72\x28\x22\x22\x2c\x20\x65\x78\x69\x74\x43\x6f\x64\x65\x29\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x62\x61\x74\x63\x68\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x66\x6e\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x46\x69\x72\x73\x74\x28\x29\x2c\x20\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x54\x61\x69\x6c\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x2f\x2f\x20\x46\x6c\x61\x67\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x6f\x6c\x6c\x6f\x77\x20\x69\x74\x73\x20\x6e\x61\x6d\x65\x2c\x20\x6c\x69\x6b\x65\x20\x60\x67\x72\x65\x65\x74\x20\x2d\x2d\x6c\x6f\x75\x64\x60\x2e\x0a\x09\x66\x6c\x61\x67\x73\x2c\x20\x5f\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x69\x6c\x74\x65\x72\x46\x6c\x61\x67\x73\x28\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x54\x61\x69\x6c\x28\x29\x29\x0a\x0a\x09\x72\x75\x6e\x6e\x65\x72\x20\x3a\x3d\x20\x62\x61\x74\x63\x68\x2e\x42\x61\x74\x63\x68\x7b\x0a\x09\x09\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x20\x66\x6e\x2e\x4e\x61\x6d\x65\x2c\x0a\x09\x09\x43\x61\x6c\x6c\x3a\x20\x20\x20\x20\x20\x20\x62\x61\x74\x63\x68\x2e\x52\x75\x6e\x6e\x65\x72\x28\x69\x6e\x70\x75\x74\x43\x61\x6c\x6c\x65\x72\x28\x66\x6e\x2c\x20\x66\x6c\x61\x67\x73\x2c\x20\x74\x6d\x29\x29\x2c\x0a\x09\x09\x57\x6f\x72\x6b\x65\x72\x73\x3a\x20\x20\x20\x63\x2e\x49\x6e\x74\x28\x22\x77\x6f\x72\x6b\x65\x72\x73\x22\x29\x2c\x0a\x09\x09\x55\x6e\x6f\x72\x64\x65\x72\x65\x64\x3a\x20\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x75\x6e\x6f\x72\x64\x65\x72\x65\x64\x22\x29\x2c\x0a\x09\x09\x46\x61\x69\x6c\x46\x61\x73\x74\x3a\x20\x20\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x66\x61\x69\x6c\x2d\x66\x61\x73\x74\x22\x29\x2c\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x63\x74\x78\x2c\x20\x73\x74\x6f\x70\x20\x3a\x3d\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x74\x6f\x70\x28\x29\x0a\x0a\x09\x73\x75\x6d\x6d\x61\x72\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x72\x75\x6e\x6e\x65\x72\x2e\x52\x75\x6e\x28\x63\x74\x78\x2c\x20\x6f\x73\x2e\x53\x74\x64\x69\x6e\x2c\x20\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x73\x75\x6d\x6d\x61\x72\x79\x2e\x46\x61\x69\x6c\x65\x64\x20\x21\x3d\x20\x30\x20\x7b\x0a\x09\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa1\xbf\x20\x25\x64\x20\x6f\x66\x20\x25\x64\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x66\x61\x69\x6c\x65\x64\x5c\x6e\x22\x2c\x20\x73\x75\x6d\x6d\x61\x72\x79\x2e\x46\x61\x69\x6c\x65\x64\x2c\x20\x73\x75\x6d\x6d\x61\x72\x79\x2e\x52\x65\x63\x6f\x72\x64\x73\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x63\x6c\x69\x2e\x4e\x65\x77\x45\x78\x69\x74\x45\x72\x72\x6f\x72\x28\x22\x22\x2c\x20\x73\x75\x6d\x6d\x61\x72\x79\x2e\x46\x69\x72\x73\x74\x2e\x45\x78\x69\x74\x43\x6f\x64\x65\x29\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x77\x61\x74\x63\x68\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x76\x61\x72\x20\x66\x6e\x73\x20\x5b\x5d\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x0a\x09\x66\x6f\x72\x20\x5f\x2c\x20\x66\x6e\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x28\x29\x20\x7b\x0a\x09\x09\x69\x66\x20\x6c\x65\x6e\x28\x66\x6e\x2e\x57\x61\x74\x63\x68\x65\x73\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x09\x63\x6f\x6e\x74\x69\x6e\x75\x65\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x2f\x2f\x20\x49\x66\x20\x6e\x61\x6d\x65\x73\x20\x61\x72\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x74\x68\x65\x6e\x20\x6f\x6e\x6c\x79\x20\x77\x61\x74\x63\x68\x20\x66\x6f\x72\x20\x74\x68\x6f\x73\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x2e\x0a\x09\x09\x69\x66\x20\x63\x2e\x4e\x41\x72\x67\x28\x29\x20\x21\x3d\x20\x30\x20\x26\x26\x20\x21\x68\x61\x73\x4e\x61\x6d\x65\x28\x63\x2e\x41\x72\x67\x73\x28\x29\x2c\x20\x66\x6e\x2e\x4e\x53\x2c\x20\x66\x6e\x2e\x4e\x61\x6d\x65\x29\x20\x7b\x0a\x09\x09\x09\x63\x6f\x6e\x74\x69\x6e\x75\x65\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x66\x6e\x73\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x66\x6e\x73\x2c\x20\x66\x6e\x29\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x6c\x65\x6e\x28\x66\x6e\x73\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x6c\x6e\x28\x22\xe2\xa1\xbf\x20\x4e\x6f\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x64\x65\x63\x6c\x61\x72\x65\x20\x61\x20\x40\x77\x61\x74\x63\x68\x28\x70\x61\x74\x68\x20\x3d\x3e\x20\x2e\x2f\x61\x73\x73\x65\x74\x73\x2c\x20\x67\x6c\x6f\x62\x20\x3d\x3e\x20\x2a\x2e\x73\x63\x73\x73\x29\x20\x61\x6e\x6e\x6f\x74\x61\x74\x69\x6f\x6e\x2e\x22\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x63\x74\x78\x2c\x20\x73\x74\x6f\x70\x20\x3a\x3d\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x74\x6f\x70\x28\x29\x0a\x0a\x09\x66\x6f\x72\x20\x5f\x2c\x20\x66\x6e\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6e\x73\x20\x7b\x0a\x09\x09\x66\x6f\x72\x20\x5f\x2c\x20\x73\x70\x65\x63\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6e\x2e\x57\x61\x74\x63\x68\x65\x73\x20\x7b\x0a\x09\x09\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa0\x99\x20\x57\x61\x74\x63\x68\x69\x6e\x67\x20\x25\x71\x20\x28\x25\x73\x29\x20\x66\x6f\x72\x20\x25\x71\x5c\x6e\x22\x2c\x20\x73\x70\x65\x63\x2e\x52\x6f\x6f\x74\x28\x29\x2c\x20\x73\x70\x65\x63\x2e\x47\x6c\x6f\x62\x2c\x20\x66\x6e\x2e\x4e\x61\x6d\x65\x29\x0a\x09\x09\x7d\x0a\x09\x7d\x0a\x0a\x09\x65\x72\x72\x20\x3d\x20\x77\x61\x74\x63\x68\x2e\x53\x65\x72\x76\x65\x28\x63\x74\x78\x2c\x20\x66\x6e\x73\x2c\x20\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x66\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x2c\x20\x70\x61\x74\x68\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x29\x20\x7b\x0a\x09\x09\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x20\x3a\x3d\x20\x66\x6e\x2e\x4e\x53\x2c\x20\x70\x61\x74\x68\x73\x0a\x09\x09\x69\x66\x20\x66\x6e\x2e\x42\x69\x6e\x61\x72\x79\x20\x21\x3d\x20\x62\x69\x6e\x4e\x61\x6d\x65\x20\x7b\x0a\x09\x09\x09\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x20\x3d\x20\x66\x6e\x2e\x42\x69\x6e\x61\x72\x79\x2c\x20\x61\x70\x70\x65\x6e\x64\x28\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x66\x6e\x2e\x4e\x53\x7d\x2c\x20\x70\x61\x74\x68\x73\x2e\x2e\x2e\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x0a\x09\x09\x09\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x69\x74\x68\x43\x68\x61\x6e\x67\x65\x64\x50\x61\x74\x68\x73\x28\x63\x74\x78\x2c\x20\x70\x61\x74\x68\x73\x29\x2c\x0a\x09\x09\x09\x63\x6d\x64\x2c\x0a\x09\x09\x09\x61\x72\x67\x73\x2c\x0a\x09\x09\x09\x6e\x69\x6c\x2c\x0a\x09\x09\x09\x73\x74\x72\x69\x6e\x67\x73\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x22\x22\x29\x2c\x0a\x09\x09\x09\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x7d\x2c\x0a\x09\x09\x09\x74\x6d\x2c\x0a\x09\x09\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x72\x69\x74\x65\x45\x72\x72\x6f\x72\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x65\x72\x72\x6f\x72\x2d\x66\x6f\x72\x6d\x61\x74\x22\x29\x2c\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x65\x77\x45\x72\x72\x6f\x72\x45\x6e\x76\x65\x6c\x6f\x70\x65\x28\x66\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x65\x72\x72\x29\x29\x0a\x09\x09\x7d\x0a\x09\x7d\x2c\x20\x66\x75\x6e\x63\x28\x65\x72\x72\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x09\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa1\xbf\x20\x57\x61\x74\x63\x68\x65\x72\x20\x65\x72\x72\x6f\x72\x3a\x20\x25\x73\x5c\x6e\x22\x2c\x20\x65\x72\x72\x29\x0a\x09\x7d\x29\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3d\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x61\x6e\x63\x65\x6c\x65\x64\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x73\x65\x72\x76\x65\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x67\x72\x61\x63\x65\x2c\x20\x67\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x67\x72\x61\x63\x65\x22\x29\x29\x0a\x09\x69\x66\x20\x67\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x49\x6e\x76\x61\x6c\x69\x64\x20\x67\x72\x61\x63\x65\x20\x64\x75\x72\x61\x74\x69\x6f\x6e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x67\x65\x72\x72\x29\x0a\x09\x7d\x0a\x0a\x09\x63\x65\x72\x74\x46\x69\x6c\x65\x2c\x20\x6b\x65\x79\x46\x69\x6c\x65\x20\x3a\x3d\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x6c\x73\x2d\x63\x65\x72\x74\x22\x29\x2c\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x6c\x73\x2d\x6b\x65\x79\x22\x29\x0a\x09\x69\x66\x20\x28\x63\x65\x72\x74\x46\x69\x6c\x65\x20\x3d\x3d\x20\x22\x22\x29\x20\x21\x3d\x20\x28\x6b\x65\x79\x46\x69\x6c\x65\x20\x3d\x3d\x20\x22\x22\x29\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x2d\x2d\x74\x6c\x73\x2d\x63\x65\x72\x74\x20\x61\x6e\x64\x20\x2d\x2d\x74\x6c\x73\x2d\x6b\x65\x79\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x73\x65\x74\x20\x74\x6f\x67\x65\x74\x68\x65\x72\x22\x29\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x73\x65\x72\x76\x65\x72\x20\x3a\x3d\x20\x26\x73\x65\x72\x76\x65\x2e\x53\x65\x72\x76\x65\x72\x7b\x0a\x09\x09\x42\x69\x6e\x61\x72\x79\x3a\x20\x20\x20\x20\x20\x20\x62\x69\x6e\x4e\x61\x6d\x65\x2c\x0a\x09\x09\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x3a\x20\x20\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x28\x29\x2c\x0a\x09\x09\x54\x6f\x6b\x65\x6e\x3a\x20\x20\x20\x20\x20\x20\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x6f\x6b\x65\x6e\x22\x29\x2c\x0a\x09\x09\x4d\x61\x78\x42\x6f\x64\x79\x3a\x20\x20\x20\x20\x20\x63\x2e\x49\x6e\x74\x36\x34\x28\x22\x6d\x61\x78\x2d\x62\x6f\x64\x79\x22\x29\x2c\x0a\x09\x09\x43\x6f\x6e\x63\x75\x72\x72\x65\x6e\x63\x79\x3a\x20\x63\x2e\x49\x6e\x74\x28\x22\x63\x6f\x6e\x63\x75\x72\x72\x65\x6e\x63\x79\x22\x29\x2c\x0a\x09\x09\x43\x61\x6c\x6c\x3a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x61\x6c\x6c\x65\x72\x28\x74\x6d\x29\x2c\x0a\x09\x7d\x0a\x0a\x09\x68\x74\x74\x70\x53\x65\x72\x76\x65\x72\x20\x3a\x3d\x20\x26\x68\x74\x74\x70\x2e\x53\x65\x72\x76\x65\x72\x7b\x0a\x09\x09\x41\x64\x64\x72\x3a\x20\x20\x20\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x61\x64\x64\x72\x22\x29\x2c\x0a\x09\x09\x48\x61\x6e\x64\x6c\x65\x72\x3a\x20\x73\x65\x72\x76\x65\x72\x2e\x48\x61\x6e\x64\x6c\x65\x72\x28\x29\x2c\x0a\x09\x09\x42\x61\x73\x65\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x66\x75\x6e\x63\x28\x6e\x65\x74\x2e\x4c\x69\x73\x74\x65\x6e\x65\x72\x29\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x61\x72\x65\x6e\x74\x0a\x09\x09\x7d\x2c\x0a\x09\x7d\x0a\x0a\x09\x73\x65\x72\x76\x65\x64\x20\x3a\x3d\x20\x6d\x61\x6b\x65\x28\x63\x68\x61\x6e\x20\x65\x72\x72\x6f\x72\x2c\x20\x31\x29\x0a\x09\x67\x6f\x20\x66\x75\x6e\x63\x28\x29\x20\x7b\x0a\x09\x09\x69\x66\x20\x63\x65\x72\x74\x46\x69\x6c\x65\x20\x21\x3d\x20\x22\x22\x20\x7b\x0a\x09\x09\x09\x73\x65\x72\x76\x65\x64\x20\x3c\x2d\x20\x68\x74\x74\x70\x53\x65\x72\x76\x65\x72\x2e\x4c\x69\x73\x74\x65\x6e\x41\x6e\x64\x53\x65\x72\x76\x65\x54\x4c\x53\x28\x63\x65\x72\x74\x46\x69\x6c\x65\x2c\x20\x6b\x65\x79\x46\x69\x6c\x65\x29\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x73\x65\x72\x76\x65\x64\x20\x3c\x2d\x20\x68\x74\x74\x70\x53\x65\x72\x76\x65\x72\x2e\x4c\x69\x73\x74\x65\x6e\x41\x6e\x64\x53\x65\x72\x76\x65\x28\x29\x0a\x09\x7d\x28\x29\x0a\x0a\x09\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x2e\x46\x72\x6f\x6d\x28\x70\x61\x72\x65\x6e\x74\x29\x2e\x49\x6e\x66\x6f\x28\x22\x53\x65\x72\x76\x69\x6e\x67\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x22\x2c\x20\x22\x61\x64\x64\x72\x22\x2c\x20\x68\x74\x74\x70\x53\x65\x72\x76\x65\x72\x2e\x41\x64\x64\x72\x2c\x20\x22\x74\x6c\x73\x22\x2c\x20\x63\x65\x72\x74\x46\x69\x6c\x65\x20\x21\x3d\x20\x22\x22\x29\x0a\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa0\x99\x20\x53\x65\x72\x76\x69\x6e\x67\x20\x25\x64\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x6f\x6e\x20\x25\x73\x5c\x6e\x22\x2c\x20\x6c\x65\x6e\x28\x73\x65\x72\x76\x65\x72\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x29\x2c\x20\x68\x74\x74\x70\x53\x65\x72\x76\x65\x72\x2e\x41\x64\x64\x72\x29\x0a\x0a\x09\x73\x69\x67\x6e\x61\x6c\x6c\x65\x64\x2c\x20\x73\x74\x6f\x70\x20\x3a\x3d\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x74\x6f\x70\x28\x29\x0a\x0a\x09\x73\x65\x6c\x65\x63\x74\x20\x7b\x0a\x09\x63\x61\x73\x65\x20\x65\x72\x72\x20\x3a\x3d\x20\x3c\x2d\x73\x65\x72\x76\x65\x64\x3a\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x63\x61\x73\x65\x20\x3c\x2d\x73\x69\x67\x6e\x61\x6c\x6c\x65\x64\x2e\x44\x6f\x6e\x65\x28\x29\x3a\x0a\x09\x7d\x0a\x0a\x09\x2f\x2f\x20\x52\x65\x61\x64\x69\x6e\x65\x73\x73\x20\x66\x61\x69\x6c\x73\x20\x77\x68\x69\x6c\x65\x20\x72\x75\x6e\x6e\x69\x6e\x67\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x66\x69\x6e\x69\x73\x68\x2c\x20\x77\x69\x74\x68\x69\x6e\x20\x74\x68\x65\x20\x67\x72\x61\x63\x65\x20\x70\x65\x72\x69\x6f\x64\x20\x69\x66\x20\x6f\x6e\x65\x20\x69\x73\x20\x73\x65\x74\x2e\x0a\x09\x73\x65\x72\x76\x65\x72\x2e\x44\x72\x61\x69\x6e\x28\x29\x0a\x0a\x09\x63\x74\x78\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x09\x69\x66\x20\x67\x72\x61\x63\x65\x20\x3e\x20\x30\x20\x7b\x0a\x09\x09\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x61\x6e\x63\x65\x6c\x46\x75\x6e\x63\x0a\x09\x09\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x74\x78\x2c\x20\x67\x72\x61\x63\x65\x29\x0a\x09\x09\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x28\x29\x0a\x09\x7d\x0a\x0a\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x6c\x6e\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa1\xbf\x20\x53\x68\x75\x74\x74\x69\x6e\x67\x20\x64\x6f\x77\x6e\x2c\x20\x77\x61\x69\x74\x69\x6e\x67\x20\x66\x6f\x72\x20\x72\x75\x6e\x6e\x69\x6e\x67\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x22\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x68\x74\x74\x70\x53\x65\x72\x76\x65\x72\x2e\x53\x68\x75\x74\x64\x6f\x77\x6e\x28\x63\x74\x78\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x75\x69\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x73\x65\x72\x76\x65\x72\x20\x3a\x3d\x20\x26\x75\x69\x2e\x53\x65\x72\x76\x65\x72\x7b\x0a\x09\x09\x42\x69\x6e\x61\x72\x79\x3a\x20\x20\x20\x20\x20\x20\x62\x69\x6e\x4e\x61\x6d\x65\x2c\x0a\x09\x09\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x3a\x20\x20\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x28\x29\x2c\x0a\x09\x09\x43\x61\x6c\x6c\x3a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x61\x6c\x6c\x65\x72\x28\x74\x6d\x29\x2c\x0a\x09\x09\x54\x69\x6d\x65\x6f\x75\x74\x3a\x20\x20\x20\x20\x20\x74\x6d\x2c\x0a\x09\x09\x52\x65\x63\x6f\x72\x64\x4c\x69\x6d\x69\x74\x3a\x20\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x49\x6e\x74\x28\x22\x72\x65\x63\x6f\x72\x64\x2d\x6c\x69\x6d\x69\x74\x22\x29\x2c\x0a\x09\x09\x52\x65\x63\x6f\x72\x64\x49\x6e\x70\x75\x74\x3a\x20\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x42\x6f\x6f\x6c\x28\x22\x72\x65\x63\x6f\x72\x64\x2d\x69\x6e\x70\x75\x74\x22\x29\x2c\x0a\x09\x09\x4d\x61\x78\x42\x6f\x64\x79\x3a\x20\x20\x20\x20\x20\x73\x65\x72\x76\x65\x2e\x44\x65\x66\x61\x75\x6c\x74\x4d\x61\x78\x42\x6f\x64\x79\x2c\x0a\x09\x7d\x0a\x0a\x09\x68\x74\x74\x70\x53\x65\x72\x76\x65\x72\x20\x3a\x3d\x20\x26\x68\x74\x74\x70\x2e\x53\x65\x72\x76\x65\x72\x7b\x0a\x09\x09\x41\x64\x64\x72\x3a\x20\x20\x20\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x61\x64\x64\x72\x22\x29\x2c\x0a\x09\x09\x48\x61\x6e\x64\x6c\x65\x72\x3a\x20\x73\x65\x72\x76\x65\x72\x2e\x48\x61\x6e\x64\x6c\x65\x72\x28\x29\x2c\x0a\x09\x09\x42\x61\x73\x65\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x66\x75\x6e\x63\x28\x6e\x65\x74\x2e\x4c\x69\x73\x74\x65\x6e\x65\x72\x29\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x61\x72\x65\x6e\x74\x0a\x09\x09\x7d\x2c\x0a\x09\x7d\x0a\x0a\x09\x73\x65\x72\x76\x65\x64\x20\x3a\x3d\x20\x6d\x61\x6b\x65\x28\x63\x68\x61\x6e\x20\x65\x72\x72\x6f\x72\x2c\x20\x31\x29\x0a\x09\x67\x6f\x20\x66\x75\x6e\x63\x28\x29\x20\x7b\x0a\x09\x09\x73\x65\x72\x76\x65\x64\x20\x3c\x2d\x20\x68\x74\x74\x70\x53\x65\x72\x76\x65\x72\x2e\x4c\x69\x73\x74\x65\x6e\x41\x6e\x64\x53\x65\x72\x76\x65\x28\x29\x0a\x09\x7d\x28\x29\x0a\x0a\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa0\x99\x20\x57\x65\x62\x20\x55\x49\x20\x66\x6f\x72\x20\x25\x64\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x61\x74\x20\x25\x73\x5c\x6e\x22\x2c\x20\x6c\x65\x6e\x28\x73\x65\x72\x76\x65\x72\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x29\x2c\x20\x75\x69\x2e\x55\x52\x4c\x28\x68\x74\x74\x70\x53\x65\x72\x76\x65\x72\x2e\x41\x64\x64\x72\x29\x29\x0a\x0a\x09\x73\x69\x67\x6e\x61\x6c\x6c\x65\x64\x2c\x20\x73\x74\x6f\x70\x20\x3a\x3d\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x74\x6f\x70\x28\x29\x0a\x0a\x09\x73\x65\x6c\x65\x63\x74\x20\x7b\x0a\x09\x63\x61\x73\x65\x20\x65\x72\x72\x20\x3a\x3d\x20\x3c\x2d\x73\x65\x72\x76\x65\x64\x3a\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x63\x61\x73\x65\x20\x3c\x2d\x73\x69\x67\x6e\x61\x6c\x6c\x65\x64\x2e\x44\x6f\x6e\x65\x28\x29\x3a\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x68\x74\x74\x70\x53\x65\x72\x76\x65\x72\x2e\x53\x68\x75\x74\x64\x6f\x77\x6e\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x6d\x63\x70\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x63\x74\x78\x2c\x20\x73\x74\x6f\x70\x20\x3a\x3d\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x74\x6f\x70\x28\x29\x0a\x0a\x09\x2f\x2f\x20\x53\x74\x64\x6f\x75\x74\x20\x63\x61\x72\x72\x69\x65\x73\x20\x74\x68\x65\x20\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x2c\x20\x73\x6f\x20\x74\x6f\x6f\x6c\x73\x20\x6f\x6e\x6c\x79\x20\x61\x6e\x73\x77\x65\x72\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x6f\x75\x74\x70\x75\x74\x20\x74\x68\x65\x79\x20\x61\x72\x65\x20\x67\x69\x76\x65\x6e\x2e\x0a\x09\x73\x65\x72\x76\x65\x72\x20\x3a\x3d\x20\x26\x6d\x63\x70\x2e\x53\x65\x72\x76\x65\x72\x7b\x0a\x09\x09\x42\x69\x6e\x61\x72\x79\x3a\x20\x20\x20\x20\x62\x69\x6e\x4e\x61\x6d\x65\x2c\x0a\x09\x09\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x20\x20\x62\x69\x6e\x56\x65\x72\x73\x69\x6f\x6e\x2c\x0a\x09\x09\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x3a\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x28\x29\x2c\x0a\x09\x09\x43\x61\x6c\x6c\x3a\x20\x20\x20\x20\x20\x20\x63\x61\x6c\x6c\x65\x72\x28\x74\x6d\x29\x2c\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x65\x72\x76\x65\x72\x2e\x53\x65\x72\x76\x65\x28\x63\x74\x78\x2c\x20\x6f\x73\x2e\x53\x74\x64\x69\x6e\x2c\x20\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x61\x6e\x63\x65\x6c\x65\x64\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x6c\x61\x6d\x62\x64\x61\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x61\x70\x69\x20\x3a\x3d\x20\x6f\x73\x2e\x47\x65\x74\x65\x6e\x76\x28\x6c\x61\x6d\x62\x64\x61\x2e\x41\x50\x49\x45\x6e\x76\x29\x0a\x09\x69\x66\x20\x61\x70\x69\x20\x3d\x3d\x20\x22\x22\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6c\x61\x6d\x62\x64\x61\x2e\x45\x72\x72\x4e\x6f\x41\x50\x49\x0a\x09\x7d\x0a\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x66\x6e\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6c\x6f\x6f\x6b\x75\x70\x28\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x68\x61\x6e\x64\x6c\x65\x72\x22\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x63\x6c\x69\x65\x6e\x74\x20\x3a\x3d\x20\x6c\x61\x6d\x62\x64\x61\x2e\x4e\x65\x77\x43\x6c\x69\x65\x6e\x74\x28\x61\x70\x69\x29\x0a\x09\x09\x63\x6c\x69\x65\x6e\x74\x2e\x49\x6e\x69\x74\x45\x72\x72\x6f\x72\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x6c\x61\x6d\x62\x64\x61\x2e\x45\x72\x72\x6f\x72\x52\x65\x73\x70\x6f\x6e\x73\x65\x7b\x45\x72\x72\x6f\x72\x4d\x65\x73\x73\x61\x67\x65\x3a\x20\x65\x72\x72\x2e\x45\x72\x72\x6f\x72\x28\x29\x2c\x20\x45\x72\x72\x6f\x72\x54\x79\x70\x65\x3a\x20\x22\x52\x75\x6e\x74\x69\x6d\x65\x2e\x49\x6e\x76\x61\x6c\x69\x64\x48\x61\x6e\x64\x6c\x65\x72\x22\x7d\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x63\x74\x78\x2c\x20\x73\x74\x6f\x70\x20\x3a\x3d\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x74\x6f\x70\x28\x29\x0a\x0a\x09\x63\x61\x6c\x6c\x20\x3a\x3d\x20\x69\x6e\x70\x75\x74\x43\x61\x6c\x6c\x65\x72\x28\x66\x6e\x2c\x20\x6e\x69\x6c\x2c\x20\x74\x6d\x29\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6c\x61\x6d\x62\x64\x61\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x61\x70\x69\x2c\x20\x66\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x69\x6e\x76\x20\x6c\x61\x6d\x62\x64\x61\x2e\x49\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x2c\x20\x69\x6e\x70\x75\x74\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x6f\x75\x74\x70\x75\x74\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x63\x61\x6c\x6c\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x69\x74\x68\x49\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x49\x44\x28\x63\x74\x78\x2c\x20\x69\x6e\x76\x2e\x52\x65\x71\x75\x65\x73\x74\x49\x44\x29\x2c\x20\x69\x6e\x70\x75\x74\x2c\x20\x6f\x75\x74\x70\x75\x74\x29\x0a\x09\x7d\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x77\x6f\x72\x6b\x65\x72\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x66\x6e\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6c\x6f\x6f\x6b\x75\x70\x28\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x66\x6e\x22\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x62\x61\x63\x6b\x6f\x66\x66\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x62\x61\x63\x6b\x6f\x66\x66\x22\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x49\x6e\x76\x61\x6c\x69\x64\x20\x62\x61\x63\x6b\x6f\x66\x66\x20\x64\x75\x72\x61\x74\x69\x6f\x6e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x09\x7d\x0a\x0a\x09\x70\x6f\x6c\x6c\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x70\x6f\x6c\x6c\x22\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x49\x6e\x76\x61\x6c\x69\x64\x20\x70\x6f\x6c\x6c\x20\x64\x75\x72\x61\x74\x69\x6f\x6e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x09\x7d\x0a\x0a\x09\x77\x6f\x72\x6b\x65\x72\x20\x3a\x3d\x20\x73\x70\x6f\x6f\x6c\x2e\x57\x6f\x72\x6b\x65\x72\x7b\x0a\x09\x09\x49\x6e\x62\x6f\x78\x3a\x20\x20\x20\x20\x20\x20\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x69\x6e\x62\x6f\x78\x22\x29\x2c\x0a\x09\x09\x4f\x75\x74\x62\x6f\x78\x3a\x20\x20\x20\x20\x20\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x6f\x75\x74\x62\x6f\x78\x22\x29\x2c\x0a\x09\x09\x46\x61\x69\x6c\x65\x64\x3a\x20\x20\x20\x20\x20\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x66\x61\x69\x6c\x65\x64\x22\x29\x2c\x0a\x09\x09\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x20\x20\x20\x66\x6e\x2e\x4e\x61\x6d\x65\x2c\x0a\x09\x09\x43\x61\x6c\x6c\x3a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x70\x6f\x6f\x6c\x2e\x52\x75\x6e\x6e\x65\x72\x28\x69\x6e\x70\x75\x74\x43\x61\x6c\x6c\x65\x72\x28\x66\x6e\x2c\x20\x6e\x69\x6c\x2c\x20\x74\x6d\x29\x29\x2c\x0a\x09\x09\x43\x6f\x6e\x63\x75\x72\x72\x65\x6e\x63\x79\x3a\x20\x63\x2e\x49\x6e\x74\x28\x22\x63\x6f\x6e\x63\x75\x72\x72\x65\x6e\x63\x79\x22\x29\x2c\x0a\x09\x09\x50\x6f\x6c\x6c\x3a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x6f\x6c\x6c\x2c\x0a\x09\x09\x4c\x6f\x67\x3a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x2c\x0a\x09\x09\x52\x65\x74\x72\x79\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x52\x65\x74\x72\x79\x7b\x0a\x09\x09\x09\x41\x74\x74\x65\x6d\x70\x74\x73\x3a\x20\x63\x2e\x49\x6e\x74\x28\x22\x61\x74\x74\x65\x6d\x70\x74\x73\x22\x29\x2c\x0a\x09\x09\x09\x42\x61\x63\x6b\x6f\x66\x66\x3a\x20\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x42\x61\x63\x6b\x6f\x66\x66\x45\x78\x70\x6f\x6e\x65\x6e\x74\x69\x61\x6c\x2c\x0a\x09\x09\x09\x49\x6e\x69\x74\x69\x61\x6c\x3a\x20\x20\x62\x61\x63\x6b\x6f\x66\x66\x2c\x0a\x09\x09\x09\x4d\x61\x78\x3a\x20\x20\x20\x20\x20\x20\x74\x69\x6d\x65\x2e\x4d\x69\x6e\x75\x74\x65\x2c\x0a\x09\x09\x09\x4f\x6e\x3a\x20\x20\x20\x20\x20\x20\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x52\x65\x74\x72\x79\x4f\x6e\x52\x65\x74\x72\x79\x61\x62\x6c\x65\x2c\x0a\x09\x09\x7d\x2c\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x63\x74\x78\x2c\x20\x73\x74\x6f\x70\x20\x3a\x3d\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x74\x6f\x70\x28\x29\x0a\x0a\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa0\x99\x20\x57\x6f\x72\x6b\x65\x72\x20\x6f\x66\x20\x25\x71\x20\x63\x6c\x61\x69\x6d\x69\x6e\x67\x20\x66\x69\x6c\x65\x73\x20\x6f\x66\x20\x25\x71\x5c\x6e\x22\x2c\x20\x66\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x77\x6f\x72\x6b\x65\x72\x2e\x49\x6e\x62\x6f\x78\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x6f\x72\x6b\x65\x72\x2e\x52\x75\x6e\x28\x63\x74\x78\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x61\x6e\x63\x65\x6c\x65\x64\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x2f\x2f\x20\x6c\x6f\x6f\x6b\x75\x70\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6f\x66\x20\x67\x69\x76\x69\x6e\x67\x20\x6e\x61\x6d\x65\x2c\x20\x77\x68\x65\x72\x65\x20\x60\x73\x75\x62\x2f\x66\x6e\x60\x20\x6e\x61\x6d\x65\x73\x20\x61\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6f\x66\x0a\x2f\x2f\x20\x74\x68\x65\x20\x60\x73\x75\x62\x60\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x2e\x0a\x66\x75\x6e\x63\x20\x6c\x6f\x6f\x6b\x75\x70\x28\x68\x61\x6e\x64\x6c\x65\x72\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x09\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x20\x3a\x3d\x20\x68\x61\x6e\x64\x6c\x65\x72\x2c\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x28\x6e\x69\x6c\x29\x0a\x09\x69\x66\x20\x69\x6e\x64\x65\x78\x20\x3a\x3d\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x49\x6e\x64\x65\x78\x28\x63\x6d\x64\x2c\x20\x22\x2f\x22\x29\x3b\x20\x69\x6e\x64\x65\x78\x20\x21\x3d\x20\x2d\x31\x20\x7b\x0a\x09\x09\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x20\x3d\x20\x63\x6d\x64\x5b\x3a\x69\x6e\x64\x65\x78\x5d\x2c\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x63\x6d\x64\x5b\x69\x6e\x64\x65\x78\x2b\x31\x3a\x5d\x7d\x0a\x09\x7d\x0a\x0a\x09\x6e\x61\x6d\x65\x20\x3a\x3d\x20\x63\x6d\x64\x0a\x09\x69\x66\x20\x6c\x65\x6e\x28\x61\x72\x67\x73\x29\x20\x21\x3d\x20\x30\x20\x7b\x0a\x09\x09\x6e\x61\x6d\x65\x20\x3d\x20\x61\x72\x67\x73\x5b\x30\x5d\x0a\x09\x7d\x0a\x0a\x09\x66\x6e\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x66\x6e\x2e\x4e\x53\x20\x21\x3d\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x54\x6f\x4c\x6f\x77\x65\x72\x28\x6e\x61\x6d\x65\x29\x20\x26\x26\x20\x66\x6e\x2e\x4e\x61\x6d\x65\x20\x21\x3d\x20\x6e\x61\x6d\x65\x20\x7b\x0a\x09\x09\x65\x72\x72\x20\x3d\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x6e\x6f\x74\x20\x66\x6f\x75\x6e\x64\x22\x2c\x20\x68\x61\x6e\x64\x6c\x65\x72\x29\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x6e\x2c\x20\x65\x72\x72\x0a\x7d\x0a\x0a\x2f\x2f\x20\x69\x6e\x70\x75\x74\x43\x61\x6c\x6c\x65\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x61\x6c\x6c\x69\x6e\x67\x20\x66\x6e\x20\x77\x69\x74\x68\x20\x69\x74\x73\x20\x69\x6e\x70\x75\x74\x20\x61\x6e\x64\x20\x67\x69\x76\x69\x6e\x67\x20\x66\x6c\x61\x67\x73\x2c\x0a\x2f\x2f\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x63\x61\x6c\x6c\x65\x72\x20\x77\x69\x74\x68\x20\x67\x69\x76\x69\x6e\x67\x20\x74\x69\x6d\x65\x6f\x75\x74\x2e\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x74\x61\x6b\x69\x6e\x67\x20\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x20\x61\x72\x65\x20\x67\x69\x76\x65\x6e\x20\x74\x68\x65\x20\x69\x74\x65\x6d\x73\x20\x6f\x66\x20\x61\x20\x4a\x53\x4f\x4e\x20\x61\x72\x72\x61\x79\x0a\x2f\x2f\x20\x72\x65\x61\x64\x20\x66\x72\x6f\x6d\x20\x69\x6e\x70\x75\x74\x2c\x20\x6c\x69\x6b\x65\x20\x60\x5b\x22\x61\x22\x2c\x20\x22\x62\x22\x5d\x60\x2e\x0a\x66\x75\x6e\x63\x20\x69\x6e\x70\x75\x74\x43\x61\x6c\x6c\x65\x72\x28\x66\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x2c\x20\x66\x6c\x61\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x74\x69\x6d\x65\x6f\x75\x74\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x29\x20\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x63\x61\x6c\x6c\x20\x3a\x3d\x20\x63\x61\x6c\x6c\x65\x72\x28\x74\x69\x6d\x65\x6f\x75\x74\x29\x0a\x09\x74\x61\x6b\x65\x73\x41\x72\x67\x73\x20\x3a\x3d\x20\x75\x69\x2e\x49\x6e\x70\x75\x74\x4f\x66\x28\x66\x6e\x29\x2e\x4b\x69\x6e\x64\x20\x3d\x3d\x20\x75\x69\x2e\x49\x6e\x70\x75\x74\x41\x72\x67\x73\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x69\x6e\x70\x75\x74\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x6f\x75\x74\x70\x75\x74\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x76\x61\x72\x20\x61\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x0a\x09\x09\x69\x66\x20\x74\x61\x6b\x65\x73\x41\x72\x67\x73\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x70\x75\x74\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x61\x72\x67\x73\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x09\x09\x09\x7d\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x63\x61\x6c\x6c\x28\x63\x74\x78\x2c\x20\x66\x6e\x2c\x20\x61\x72\x67\x73\x2c\x20\x66\x6c\x61\x67\x73\x2c\x20\x69\x6e\x70\x75\x74\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x6f\x75\x74\x70\x75\x74\x7d\x29\x0a\x09\x7d\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x64\x61\x65\x6d\x6f\x6e\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x64\x69\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x47\x65\x74\x77\x64\x28\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x6c\x69\x73\x74\x65\x6e\x65\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x64\x61\x65\x6d\x6f\x6e\x2e\x4c\x69\x73\x74\x65\x6e\x28\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x73\x6f\x63\x6b\x65\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x63\x74\x78\x2c\x20\x73\x74\x6f\x70\x20\x3a\x3d\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x74\x6f\x70\x28\x29\x0a\x0a\x09\x76\x61\x72\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x0a\x09\x66\x6f\x72\x20\x5f\x2c\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x63\x2e\x41\x70\x70\x2e\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x7b\x0a\x09\x09\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x2c\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x2e\x4e\x61\x6d\x65\x29\x0a\x09\x7d\x0a\x0a\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa0\x99\x20\x44\x61\x65\x6d\x6f\x6e\x20\x6c\x69\x73\x74\x65\x6e\x69\x6e\x67\x20\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x69\x6e\x20\x25\x71\x5c\x6e\x22\x2c\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x73\x6f\x63\x6b\x65\x74\x22\x29\x2c\x20\x64\x69\x72\x29\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x64\x61\x65\x6d\x6f\x6e\x2e\x53\x65\x72\x76\x65\x28\x63\x74\x78\x2c\x20\x6c\x69\x73\x74\x65\x6e\x65\x72\x2c\x20\x64\x61\x65\x6d\x6f\x6e\x50\x72\x65\x70\x61\x72\x65\x72\x28\x64\x69\x72\x2c\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x29\x29\x0a\x7d\x0a\x0a\x2f\x2f\x20\x64\x61\x65\x6d\x6f\x6e\x50\x72\x65\x70\x61\x72\x65\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x64\x61\x65\x6d\x6f\x6e\x2e\x50\x72\x65\x70\x61\x72\x65\x72\x20\x77\x68\x69\x63\x68\x20\x63\x61\x6c\x6c\x73\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x6c\x69\x6b\x65\x20\x6d\x61\x69\x6e\x41\x63\x74\x69\x6f\x6e\x0a\x2f\x2f\x20\x64\x6f\x65\x73\x2c\x20\x66\x6f\x72\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x6d\x61\x64\x65\x20\x77\x69\x74\x68\x69\x6e\x20\x64\x69\x72\x2e\x20\x52\x65\x71\x75\x65\x73\x74\x73\x20\x66\x6f\x72\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x2c\x20\x6f\x72\x20\x77\x69\x74\x68\x20\x67\x6c\x6f\x62\x61\x6c\x20\x66\x6c\x61\x67\x73\x0a\x2f\x2f\x20\x6f\x74\x68\x65\x72\x20\x74\x68\x61\x6e\x20\x60\x2d\x74\x60\x2c\x20\x60\x2d\x2d\x65\x72\x72\x6f\x72\x2d\x66\x6f\x72\x6d\x61\x74\x60\x20\x61\x6e\x64\x20\x60\x2d\x2d\x65\x6e\x76\x65\x6c\x6f\x70\x65\x60\x2c\x20\x61\x72\x65\x20\x72\x65\x6a\x65\x63\x74\x65\x64\x20\x73\x6f\x20\x63\x6c\x69\x65\x6e\x74\x73\x20\x72\x75\x6e\x0a\x2f\x2f\x20\x74\x68\x65\x6d\x20\x62\x79\x20\x65\x78\x65\x63\x75\x74\x69\x6e\x67\x20\x74\x68\x65\x20\x62\x69\x6e\x61\x72\x79\x2e\x0a\x66\x75\x6e\x63\x20\x64\x61\x65\x6d\x6f\x6e\x50\x72\x65\x70\x61\x72\x65\x72\x28\x64\x69\x72\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x29\x20\x64\x61\x65\x6d\x6f\x6e\x2e\x50\x72\x65\x70\x61\x72\x65\x72\x20\x7b\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x28\x72\x65\x71\x20\x64\x61\x65\x6d\x6f\x6e\x2e\x52\x65\x71\x75\x65\x73\x74\x29\x20\x28\x64\x61\x65\x6d\x6f\x6e\x2e\x43\x61\x6c\x6c\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x09\x09\x69\x66\x20\x72\x65\x71\x2e\x44\x69\x72\x20\x21\x3d\x20\x64\x69\x72\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x64\x61\x65\x6d\x6f\x6e\x20\x72\x75\x6e\x73\x20\x77\x69\x74\x68\x69\x6e\x20\x25\x71\x22\x2c\x20\x64\x69\x72\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x73\x65\x74\x20\x3a\x3d\x20\x66\x6c\x61\x67\x2e\x4e\x65\x77\x46\x6c\x61\x67\x53\x65\x74\x28\x62\x69\x6e\x4e\x61\x6d\x65\x2c\x20\x66\x6c\x61\x67\x2e\x43\x6f\x6e\x74\x69\x6e\x75\x65\x4f\x6e\x45\x72\x72\x6f\x72\x29\x0a\x09\x09\x73\x65\x74\x2e\x53\x65\x74\x4f\x75\x74\x70\x75\x74\x28\x69\x6f\x2e\x44\x69\x73\x63\x61\x72\x64\x29\x0a\x0a\x09\x09\x74\x69\x6d\x65\x6f\x75\x74\x20\x3a\x3d\x20\x73\x65\x74\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x22\x2c\x20\x22\x22\x2c\x20\x22\x22\x29\x0a\x09\x09\x73\x65\x74\x2e\x53\x74\x72\x69\x6e\x67\x56\x61\x72\x28\x74\x69\x6d\x65\x6f\x75\x74\x2c\x20\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x2c\x20\x22\x22\x2c\x20\x22\x22\x29\x0a\x09\x09\x65\x72\x72\x6f\x72\x46\x6f\x72\x6d\x61\x74\x20\x3a\x3d\x20\x73\x65\x74\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x65\x72\x72\x6f\x72\x2d\x66\x6f\x72\x6d\x61\x74\x22\x2c\x20\x22\x6a\x73\x6f\x6e\x22\x2c\x20\x22\x22\x29\x0a\x09\x09\x75\x73\x65\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x3a\x3d\x20\x73\x65\x74\x2e\x42\x6f\x6f\x6c\x28\x22\x65\x6e\x76\x65\x6c\x6f\x70\x65\x22\x2c\x20\x66\x61\x6c\x73\x65\x2c\x20\x22\x22\x29\x0a\x0a\x09\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x65\x74\x2e\x50\x61\x72\x73\x65\x28\x72\x65\x71\x2e\x41\x72\x67\x73\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x65\x72\x72\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x61\x72\x67\x73\x20\x3a\x3d\x20\x73\x65\x74\x2e\x41\x72\x67\x73\x28\x29\x0a\x09\x09\x69\x66\x20\x6c\x65\x6e\x28\x61\x72\x67\x73\x29\x20\x3d\x3d\x20\x30\x20\x7c\x7c\x20\x68\x61\x73\x4e\x61\x6d\x65\x28\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x2c\x20\x61\x72\x67\x73\x5b\x30\x5d\x29\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x65\x72\x72\x6f\x72\x73\x2e\x4e\x65\x77\x28\x22\x64\x61\x65\x6d\x6f\x6e\x20\x6f\x6e\x6c\x79\x20\x63\x61\x6c\x6c\x73\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x22\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x2f\x2f\x20\x49\x6e\x70\x75\x74\x20\x6f\x66\x20\x61\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x20\x69\x73\x20\x6d\x65\x72\x67\x65\x64\x20\x77\x69\x74\x68\x20\x73\x74\x64\x69\x6e\x2c\x20\x77\x68\x69\x63\x68\x20\x74\x68\x65\x20\x63\x6c\x69\x65\x6e\x74\x20\x6d\x61\x79\x20\x68\x6f\x6c\x64\x20\x61\x73\x20\x61\x20\x74\x65\x72\x6d\x69\x6e\x61\x6c\x2e\x0a\x09\x09\x69\x66\x20\x6d\x65\x74\x61\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x61\x72\x67\x73\x5b\x30\x5d\x2c\x20\x61\x72\x67\x73\x5b\x31\x3a\x5d\x29\x3b\x20\x65\x72\x72\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x74\x61\x6b\x65\x73\x4a\x53\x4f\x4e\x28\x6d\x65\x74\x61\x29\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x61\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x2c\x20\x5f\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x41\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x28\x61\x72\x67\x73\x5b\x31\x3a\x5d\x29\x3b\x20\x6c\x65\x6e\x28\x61\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x29\x20\x21\x3d\x20\x30\x20\x7b\x0a\x09\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x65\x72\x72\x6f\x72\x73\x2e\x4e\x65\x77\x28\x22\x64\x61\x65\x6d\x6f\x6e\x20\x64\x6f\x65\x73\x20\x6e\x6f\x74\x20\x74\x61\x6b\x65\x20\x6b\x65\x79\x3d\x76\x61\x6c\x75\x65\x20\x69\x6e\x70\x75\x74\x22\x29\x0a\x09\x09\x09\x7d\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x2a\x74\x69\x6d\x65\x6f\x75\x74\x29\x0a\x09\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x69\x6e\x70\x75\x74\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x73\x74\x64\x6f\x75\x74\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x2c\x20\x73\x74\x64\x65\x72\x72\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x29\x20\x69\x6e\x74\x20\x7b\x0a\x09\x09\x09\x63\x6d\x64\x2c\x20\x72\x65\x73\x74\x20\x3a\x3d\x20\x61\x72\x67\x73\x5b\x30\x5d\x2c\x20\x61\x72\x67\x73\x5b\x31\x3a\x5d\x0a\x09\x09\x09\x66\x6c\x61\x67\x73\x2c\x20\x5f\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x69\x6c\x74\x65\x72\x46\x6c\x61\x67\x73\x28\x72\x65\x73\x74\x29\x0a\x0a\x09\x09\x09\x6e\x61\x6d\x65\x20\x3a\x3d\x20\x63\x6d\x64\x0a\x09\x09\x09\x69\x66\x20\x6d\x65\x74\x61\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x63\x6d\x64\x2c\x20\x72\x65\x73\x74\x29\x3b\x20\x65\x72\x72\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x6e\x61\x6d\x65\x20\x3d\x20\x6d\x65\x74\x61\x2e\x4e\x61\x6d\x65\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x6f\x75\x74\x70\x75\x74\x20\x3a\x3d\x20\x73\x74\x64\x6f\x75\x74\x0a\x0a\x09\x09\x09\x76\x61\x72\x20\x72\x65\x73\x75\x6c\x74\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x09\x09\x09\x69\x66\x20\x2a\x75\x73\x65\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x7b\x0a\x09\x09\x09\x09\x6f\x75\x74\x70\x75\x74\x20\x3d\x20\x26\x72\x65\x73\x75\x6c\x74\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x73\x74\x61\x72\x74\x65\x64\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x4e\x6f\x77\x28\x29\x0a\x09\x09\x09\x65\x72\x72\x20\x3a\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x63\x74\x78\x2c\x20\x63\x6d\x64\x2c\x20\x72\x65\x73\x74\x2c\x20\x66\x6c\x61\x67\x73\x2c\x20\x69\x6e\x70\x75\x74\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x6f\x75\x74\x70\x75\x74\x7d\x2c\x20\x74\x6d\x29\x0a\x09\x09\x09\x69\x66\x20\x65\x72\x72\x20\x3d\x3d\x20\x70\x6b\x67\x2e\x45\x72\x72\x4e\x6f\x44\x65\x66\x61\x75\x6c\x74\x20\x7b\x0a\x09\x09\x09\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x6c\x6e\x28\x73\x74\x64\x6f\x75\x74\x2c\x20\x68\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x29\x0a\x09\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x30\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x76\x61\x72\x20\x65\x6e\x76\x65\x6c\x6f\x70\x65\x20\x2a\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x45\x6e\x76\x65\x6c\x6f\x70\x65\x0a\x09\x09\x09\x65\x78\x69\x74\x43\x6f\x64\x65\x20\x3a\x3d\x20\x30\x0a\x0a\x09\x09\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x66\x61\x69\x6c\x75\x72\x65\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x65\x77\x45\x72\x72\x6f\x72\x45\x6e\x76\x65\x6c\x6f\x70\x65\x28\x6e\x61\x6d\x65\x2c\x20\x65\x72\x72\x29\x0a\x09\x09\x09\x09\x65\x6e\x76\x65\x6c\x6f\x70\x65\x2c\x20\x65\x78\x69\x74\x43\x6f\x64\x65\x20\x3d\x20\x26\x66\x61\x69\x6c\x75\x72\x65\x2c\x20\x66\x61\x69\x6c\x75\x72\x65\x2e\x45\x78\x69\x74\x43\x6f\x64\x65\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x69\x66\x20\x2a\x75\x73\x65\x45\x6e\x76\x65\x6c\x6f\x70\x65\x20\x7b\x0a\x09\x09\x09\x09\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x45\x6e\x63\x6f\x64\x65\x72\x28\x73\x74\x64\x6f\x75\x74\x29\x2e\x45\x6e\x63\x6f\x64\x65\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x65\x77\x52\x65\x73\x75\x6c\x74\x45\x6e\x76\x65\x6c\x6f\x70\x65\x28\x6e\x61\x6d\x65\x2c\x20\x72\x65\x73\x75\x6c\x74\x2e\x42\x79\x74\x65\x73\x28\x29\x2c\x20\x65\x6e\x76\x65\x6c\x6f\x70\x65\x2c\x20\x74\x69\x6d\x65\x2e\x53\x69\x6e\x63\x65\x28\x73\x74\x61\x72\x74\x65\x64\x29\x29\x29\x0a\x09\x09\x09\x7d\x20\x65\x6c\x73\x65\x20\x69\x66\x20\x65\x6e\x76\x65\x6c\x6f\x70\x65\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x72\x69\x74\x65\x45\x72\x72\x6f\x72\x28\x73\x74\x64\x65\x72\x72\x2c\x20\x2a\x65\x72\x72\x6f\x72\x46\x6f\x72\x6d\x61\x74\x2c\x20\x2a\x65\x6e\x76\x65\x6c\x6f\x70\x65\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x78\x69\x74\x43\x6f\x64\x65\x0a\x09\x09\x7d\x2c\x20\x6e\x69\x6c\x0a\x09\x7d\x0a\x7d\x0a\x0a\x2f\x2f\x20\x63\x67\x69\x41\x63\x74\x69\x6f\x6e\x20\x73\x65\x72\x76\x65\x73\x20\x74\x68\x65\x20\x72\x65\x71\x75\x65\x73\x74\x20\x6f\x66\x20\x61\x20\x43\x47\x49\x20\x73\x63\x72\x69\x70\x74\x20\x6c\x69\x6b\x65\x20\x74\x68\x65\x20\x73\x65\x72\x76\x65\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x64\x6f\x65\x73\x2c\x20\x77\x69\x74\x68\x0a\x2f\x2f\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x6d\x61\x74\x63\x68\x65\x64\x20\x74\x6f\x20\x74\x68\x65\x20\x70\x61\x74\x68\x20\x61\x66\x74\x65\x72\x20\x74\x68\x65\x20\x73\x63\x72\x69\x70\x74\x27\x73\x2e\x0a\x66\x75\x6e\x63\x20\x63\x67\x69\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x63\x67\x69\x2e\x53\x65\x72\x76\x65\x28\x67\x61\x74\x65\x77\x61\x79\x48\x61\x6e\x64\x6c\x65\x72\x28\x63\x2c\x20\x70\x61\x72\x65\x6e\x74\x2c\x20\x6f\x73\x2e\x47\x65\x74\x65\x6e\x76\x28\x22\x53\x43\x52\x49\x50\x54\x5f\x4e\x41\x4d\x45\x22\x29\x29\x29\x0a\x7d\x0a\x0a\x2f\x2f\x20\x66\x63\x67\x69\x41\x63\x74\x69\x6f\x6e\x20\x73\x65\x72\x76\x65\x73\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x6f\x66\x20\x61\x20\x77\x65\x62\x20\x73\x65\x72\x76\x65\x72\x20\x6f\x76\x65\x72\x20\x46\x61\x73\x74\x43\x47\x49\x20\x6c\x69\x6b\x65\x20\x74\x68\x65\x20\x73\x65\x72\x76\x65\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x64\x6f\x65\x73\x2c\x0a\x2f\x2f\x20\x75\x6e\x74\x69\x6c\x20\x53\x49\x47\x49\x4e\x54\x20\x6f\x72\x20\x53\x49\x47\x54\x45\x52\x4d\x2e\x0a\x66\x75\x6e\x63\x20\x66\x63\x67\x69\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x76\x61\x72\x20\x6c\x69\x73\x74\x65\x6e\x65\x72\x20\x6e\x65\x74\x2e\x4c\x69\x73\x74\x65\x6e\x65\x72\x0a\x09\x69\x66\x20\x61\x64\x64\x72\x20\x3a\x3d\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x66\x63\x67\x69\x2d\x61\x64\x64\x72\x22\x29\x3b\x20\x61\x64\x64\x72\x20\x21\x3d\x20\x22\x22\x20\x7b\x0a\x09\x09\x6e\x65\x74\x77\x6f\x72\x6b\x20\x3a\x3d\x20\x22\x74\x63\x70\x22\x0a\x09\x09\x69\x66\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x43\x6f\x6e\x74\x61\x69\x6e\x73\x28\x61\x64\x64\x72\x2c\x20\x22\x2f\x22\x29\x20\x7b\x0a\x09\x09\x09\x6e\x65\x74\x77\x6f\x72\x6b\x20\x3d\x20\x22\x75\x6e\x69\x78\x22\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x6c\x69\x73\x74\x65\x6e\x65\x72\x2c\x20\x65\x72\x72\x20\x3d\x20\x6e\x65\x74\x2e\x4c\x69\x73\x74\x65\x6e\x28\x6e\x65\x74\x77\x6f\x72\x6b\x2c\x20\x61\x64\x64\x72\x29\x0a\x09\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x09\x09\x6c\x69\x73\x74\x65\x6e\x65\x72\x2c\x20\x65\x72\x72\x20\x3d\x20\x6e\x65\x74\x2e\x46\x69\x6c\x65\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x6f\x73\x2e\x53\x74\x64\x69\x6e\x29\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x46\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x6c\x69\x73\x74\x65\x6e\x20\x66\x6f\x72\x20\x46\x61\x73\x74\x43\x47\x49\x3a\x20\x25\x73\x22\x2c\x20\x65\x72\x72\x29\x0a\x09\x7d\x0a\x0a\x09\x73\x69\x67\x6e\x61\x6c\x6c\x65\x64\x2c\x20\x73\x74\x6f\x70\x20\x3a\x3d\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x74\x6f\x70\x28\x29\x0a\x0a\x09\x67\x6f\x20\x66\x75\x6e\x63\x28\x29\x20\x7b\x0a\x09\x09\x3c\x2d\x73\x69\x67\x6e\x61\x6c\x6c\x65\x64\x2e\x44\x6f\x6e\x65\x28\x29\x0a\x09\x09\x6c\x69\x73\x74\x65\x6e\x65\x72\x2e\x43\x6c\x6f\x73\x65\x28\x29\x0a\x09\x7d\x28\x29\x0a\x0a\x09\x65\x72\x72\x20\x3d\x20\x66\x63\x67\x69\x2e\x53\x65\x72\x76\x65\x28\x6c\x69\x73\x74\x65\x6e\x65\x72\x2c\x20\x67\x61\x74\x65\x77\x61\x79\x48\x61\x6e\x64\x6c\x65\x72\x28\x63\x2c\x20\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x66\x63\x67\x69\x2d\x70\x72\x65\x66\x69\x78\x22\x29\x29\x29\x0a\x09\x69\x66\x20\x73\x69\x67\x6e\x61\x6c\x6c\x65\x64\x2e\x45\x72\x72\x28\x29\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x7d\x0a\x0a\x2f\x2f\x20\x67\x61\x74\x65\x77\x61\x79\x48\x61\x6e\x64\x6c\x65\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x68\x61\x6e\x64\x6c\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x73\x65\x72\x76\x65\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x66\x6f\x72\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x6f\x66\x20\x77\x65\x62\x20\x73\x65\x72\x76\x65\x72\x73\x2c\x0a\x2f\x2f\x20\x77\x69\x74\x68\x20\x70\x72\x65\x66\x69\x78\x20\x73\x74\x72\x69\x70\x70\x65\x64\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x69\x72\x20\x70\x61\x74\x68\x73\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x6c\x6f\x67\x67\x65\x72\x20\x6f\x66\x20\x70\x61\x72\x65\x6e\x74\x20\x69\x6e\x20\x74\x68\x65\x69\x72\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x20\x41\x73\x0a\x2f\x2f\x20\x74\x68\x65\x79\x20\x61\x72\x65\x20\x73\x74\x61\x72\x74\x65\x64\x20\x62\x79\x20\x77\x65\x62\x20\x73\x65\x72\x76\x65\x72\x73\x2c\x20\x74\x68\x65\x20\x74\x6f\x6b\x65\x6e\x20\x69\x73\x20\x6f\x6e\x6c\x79\x20\x73\x65\x74\x20\x62\x79\x20\x53\x48\x4f\x47\x55\x4e\x5f\x53\x45\x52\x56\x45\x5f\x54\x4f\x4b\x45\x4e\x2e\x0a\x66\x75\x6e\x63\x20\x67\x61\x74\x65\x77\x61\x79\x48\x61\x6e\x64\x6c\x65\x72\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x70\x61\x72\x65\x6e\x74\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x70\x72\x65\x66\x69\x78\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x68\x74\x74\x70\x2e\x48\x61\x6e\x64\x6c\x65\x72\x20\x7b\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x73\x65\x72\x76\x65\x72\x20\x3a\x3d\x20\x26\x73\x65\x72\x76\x65\x2e\x53\x65\x72\x76\x65\x72\x7b\x0a\x09\x09\x42\x69\x6e\x61\x72\x79\x3a\x20\x20\x20\x20\x62\x69\x6e\x4e\x61\x6d\x65\x2c\x0a\x09\x09\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x3a\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x28\x29\x2c\x0a\x09\x09\x54\x6f\x6b\x65\x6e\x3a\x20\x20\x20\x20\x20\x6f\x73\x2e\x47\x65\x74\x65\x6e\x76\x28\x22\x53\x48\x4f\x47\x55\x4e\x5f\x53\x45\x52\x56\x45\x5f\x54\x4f\x4b\x45\x4e\x22\x29\x2c\x0a\x09\x09\x4d\x61\x78\x42\x6f\x64\x79\x3a\x20\x20\x20\x73\x65\x72\x76\x65\x2e\x44\x65\x66\x61\x75\x6c\x74\x4d\x61\x78\x42\x6f\x64\x79\x2c\x0a\x09\x09\x43\x61\x6c\x6c\x3a\x20\x20\x20\x20\x20\x20\x63\x61\x6c\x6c\x65\x72\x28\x74\x6d\x29\x2c\x0a\x09\x7d\x0a\x0a\x09\x68\x61\x6e\x64\x6c\x65\x72\x20\x3a\x3d\x20\x68\x74\x74\x70\x2e\x53\x74\x72\x69\x70\x50\x72\x65\x66\x69\x78\x28\x73\x74\x72\x69\x6e\x67\x73\x2e\x54\x72\x69\x6d\x53\x75\x66\x66\x69\x78\x28\x70\x72\x65\x66\x69\x78\x2c\x20\x22\x2f\x22\x29\x2c\x20\x73\x65\x72\x76\x65\x72\x2e\x48\x61\x6e\x64\x6c\x65\x72\x28\x29\x29\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x68\x74\x74\x70\x2e\x48\x61\x6e\x64\x6c\x65\x72\x46\x75\x6e\x63\x28\x66\x75\x6e\x63\x28\x77\x20\x68\x74\x74\x70\x2e\x52\x65\x73\x70\x6f\x6e\x73\x65\x57\x72\x69\x74\x65\x72\x2c\x20\x72\x20\x2a\x68\x74\x74\x70\x2e\x52\x65\x71\x75\x65\x73\x74\x29\x20\x7b\x0a\x09\x09\x68\x61\x6e\x64\x6c\x65\x72\x2e\x53\x65\x72\x76\x65\x48\x54\x54\x50\x28\x77\x2c\x20\x72\x2e\x57\x69\x74\x68\x43\x6f\x6e\x74\x65\x78\x74\x28\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x2e\x57\x69\x74\x68\x28\x72\x2e\x43\x6f\x6e\x74\x65\x78\x74\x28\x29\x2c\x20\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x2e\x46\x72\x6f\x6d\x28\x70\x61\x72\x65\x6e\x74\x29\x29\x29\x29\x0a\x09\x7d\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x73\x63\x68\x65\x64\x75\x6c\x65\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x74\x6d\x2c\x20\x74\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x74\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x6d\x20\x3d\x20\x30\x0a\x09\x7d\x0a\x0a\x09\x76\x61\x72\x20\x66\x6e\x73\x20\x5b\x5d\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x0a\x09\x66\x6f\x72\x20\x5f\x2c\x20\x66\x6e\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x28\x29\x20\x7b\x0a\x09\x09\x69\x66\x20\x6c\x65\x6e\x28\x66\x6e\x2e\x43\x72\x6f\x6e\x73\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x09\x63\x6f\x6e\x74\x69\x6e\x75\x65\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x2f\x2f\x20\x49\x66\x20\x6e\x61\x6d\x65\x73\x20\x61\x72\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x74\x68\x65\x6e\x20\x6f\x6e\x6c\x79\x20\x73\x63\x68\x65\x64\x75\x6c\x65\x20\x74\x68\x6f\x73\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x2e\x0a\x09\x09\x69\x66\x20\x63\x2e\x4e\x41\x72\x67\x28\x29\x20\x21\x3d\x20\x30\x20\x26\x26\x20\x21\x68\x61\x73\x4e\x61\x6d\x65\x28\x63\x2e\x41\x72\x67\x73\x28\x29\x2c\x20\x66\x6e\x2e\x4e\x53\x2c\x20\x66\x6e\x2e\x4e\x61\x6d\x65\x29\x20\x7b\x0a\x09\x09\x09\x63\x6f\x6e\x74\x69\x6e\x75\x65\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x66\x6e\x73\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x66\x6e\x73\x2c\x20\x66\x6e\x29\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x6c\x65\x6e\x28\x66\x6e\x73\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x6c\x6e\x28\x22\xe2\xa1\xbf\x20\x4e\x6f\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x64\x65\x63\x6c\x61\x72\x65\x20\x61\x20\x40\x63\x72\x6f\x6e\x28\x65\x78\x70\x72\x20\x3d\x3e\x20\x5c\x22\x2a\x2f\x35\x20\x2a\x20\x2a\x20\x2a\x20\x2a\x5c\x22\x29\x20\x61\x6e\x6e\x6f\x74\x61\x74\x69\x6f\x6e\x2e\x22\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x7d\x0a\x0a\x09\x73\x63\x68\x65\x64\x75\x6c\x65\x72\x20\x3a\x3d\x20\x73\x63\x68\x65\x64\x75\x6c\x65\x2e\x53\x63\x68\x65\x64\x75\x6c\x65\x72\x7b\x0a\x09\x09\x4c\x6f\x67\x3a\x20\x20\x20\x20\x20\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x2c\x0a\x09\x09\x4f\x76\x65\x72\x6c\x61\x70\x3a\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x6f\x76\x65\x72\x6c\x61\x70\x22\x29\x2c\x0a\x09\x09\x43\x61\x6c\x6c\x3a\x20\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x66\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x2c\x20\x6f\x75\x74\x70\x75\x74\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x09\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x20\x3a\x3d\x20\x66\x6e\x2e\x4e\x53\x2c\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x28\x6e\x69\x6c\x29\x0a\x09\x09\x09\x69\x66\x20\x66\x6e\x2e\x42\x69\x6e\x61\x72\x79\x20\x21\x3d\x20\x62\x69\x6e\x4e\x61\x6d\x65\x20\x7b\x0a\x09\x09\x09\x09\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x20\x3d\x20\x66\x6e\x2e\x42\x69\x6e\x61\x72\x79\x2c\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x66\x6e\x2e\x4e\x53\x7d\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x63\x74\x78\x2c\x20\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x2c\x20\x6e\x69\x6c\x2c\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x22\x22\x29\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x6f\x75\x74\x70\x75\x74\x7d\x2c\x20\x74\x6d\x29\x0a\x09\x09\x7d\x2c\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x74\x7a\x20\x3a\x3d\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x7a\x22\x29\x3b\x20\x74\x7a\x20\x21\x3d\x20\x22\x22\x20\x7b\x0a\x09\x09\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x4c\x6f\x61\x64\x4c\x6f\x63\x61\x74\x69\x6f\x6e\x28\x74\x7a\x29\x0a\x09\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x73\x63\x68\x65\x64\x75\x6c\x65\x72\x2e\x4c\x6f\x63\x61\x74\x69\x6f\x6e\x20\x3d\x20\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x6a\x69\x74\x74\x65\x72\x20\x3a\x3d\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x6a\x69\x74\x74\x65\x72\x22\x29\x3b\x20\x6a\x69\x74\x74\x65\x72\x20\x21\x3d\x20\x22\x22\x20\x7b\x0a\x09\x09\x64\x75\x72\x61\x74\x69\x6f\x6e\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x74\x69\x6d\x65\x2e\x50\x61\x72\x73\x65\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x6a\x69\x74\x74\x65\x72\x29\x0a\x09\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x49\x6e\x76\x61\x6c\x69\x64\x20\x6a\x69\x74\x74\x65\x72\x20\x64\x75\x72\x61\x74\x69\x6f\x6e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x73\x63\x68\x65\x64\x75\x6c\x65\x72\x2e\x4a\x69\x74\x74\x65\x72\x20\x3d\x20\x64\x75\x72\x61\x74\x69\x6f\x6e\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x6c\x6f\x67\x46\x69\x6c\x65\x20\x3a\x3d\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x6c\x6f\x67\x22\x29\x3b\x20\x6c\x6f\x67\x46\x69\x6c\x65\x20\x21\x3d\x20\x22\x22\x20\x7b\x0a\x09\x09\x66\x69\x6c\x65\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x4f\x70\x65\x6e\x46\x69\x6c\x65\x28\x6c\x6f\x67\x46\x69\x6c\x65\x2c\x20\x6f\x73\x2e\x4f\x5f\x43\x52\x45\x41\x54\x45\x7c\x6f\x73\x2e\x4f\x5f\x41\x50\x50\x45\x4e\x44\x7c\x6f\x73\x2e\x4f\x5f\x57\x52\x4f\x4e\x4c\x59\x2c\x20\x30\x36\x34\x34\x29\x0a\x09\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x64\x65\x66\x65\x72\x20\x66\x69\x6c\x65\x2e\x43\x6c\x6f\x73\x65\x28\x29\x0a\x09\x09\x73\x63\x68\x65\x64\x75\x6c\x65\x72\x2e\x4c\x6f\x67\x20\x3d\x20\x66\x69\x6c\x65\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x0a\x09\x63\x74\x78\x2c\x20\x73\x74\x6f\x70\x20\x3a\x3d\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x0a\x09\x64\x65\x66\x65\x72\x20\x73\x74\x6f\x70\x28\x29\x0a\x0a\x09\x66\x6f\x72\x20\x5f\x2c\x20\x66\x6e\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6e\x73\x20\x7b\x0a\x09\x09\x66\x6f\x72\x20\x5f\x2c\x20\x63\x72\x6f\x6e\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6e\x2e\x43\x72\x6f\x6e\x73\x20\x7b\x0a\x09\x09\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa0\x99\x20\x53\x63\x68\x65\x64\x75\x6c\x65\x64\x20\x25\x71\x20\x61\x74\x20\x25\x71\x5c\x6e\x22\x2c\x20\x66\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x63\x72\x6f\x6e\x2e\x45\x78\x70\x72\x29\x0a\x09\x09\x7d\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x63\x68\x65\x64\x75\x6c\x65\x72\x2e\x52\x75\x6e\x28\x63\x74\x78\x2c\x20\x66\x6e\x73\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x61\x6e\x63\x65\x6c\x65\x64\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x2f\x2f\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x64\x65\x72\x69\x76\x65\x64\x20\x66\x72\x6f\x6d\x20\x70\x61\x72\x65\x6e\x74\x20\x77\x68\x69\x63\x68\x20\x69\x73\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x64\x20\x6f\x6e\x20\x53\x49\x47\x49\x4e\x54\x0a\x2f\x2f\x20\x6f\x72\x20\x53\x49\x47\x54\x45\x52\x4d\x2c\x20\x77\x69\x74\x68\x20\x61\x20\x73\x65\x63\x6f\x6e\x64\x20\x73\x69\x67\x6e\x61\x6c\x20\x66\x6f\x72\x63\x69\x6e\x67\x20\x61\x6e\x20\x65\x78\x69\x74\x2c\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x77\x68\x69\x63\x68\x20\x73\x74\x6f\x70\x73\x0a\x2f\x2f\x20\x77\x61\x74\x63\x68\x69\x6e\x67\x20\x66\x6f\x72\x20\x73\x69\x67\x6e\x61\x6c\x73\x20\x61\x6e\x64\x20\x72\x65\x6c\x65\x61\x73\x65\x73\x20\x69\x74\x2e\x0a\x66\x75\x6e\x63\x20\x73\x69\x67\x6e\x61\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x66\x75\x6e\x63\x28\x29\x29\x20\x7b\x0a\x09\x63\x61\x6e\x63\x65\x6c\x6c\x61\x74\x69\x6f\x6e\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x43\x61\x6e\x63\x65\x6c\x4f\x6e\x53\x69\x67\x6e\x61\x6c\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x30\x2c\x20\x66\x75\x6e\x63\x28\x72\x65\x61\x73\x6f\x6e\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x7b\x0a\x09\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa1\xbf\x20\x25\x73\x5c\x6e\x22\x2c\x20\x72\x65\x61\x73\x6f\x6e\x29\x0a\x09\x09\x6f\x73\x2e\x45\x78\x69\x74\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x78\x69\x74\x43\x61\x6e\x63\x65\x6c\x6c\x65\x64\x29\x0a\x09\x7d\x29\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x63\x61\x6e\x63\x65\x6c\x6c\x61\x74\x69\x6f\x6e\x2e\x43\x6f\x6e\x74\x65\x78\x74\x28\x29\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x61\x74\x69\x6f\x6e\x2e\x53\x74\x6f\x70\x0a\x7d\x0a\x0a\x2f\x2f\x20\x68\x61\x73\x4e\x61\x6d\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x72\x75\x65\x2f\x66\x61\x6c\x73\x65\x20\x69\x66\x20\x61\x6e\x79\x20\x6f\x66\x20\x74\x68\x65\x20\x6e\x61\x6d\x65\x73\x20\x61\x72\x65\x20\x77\x69\x74\x68\x69\x6e\x20\x67\x69\x76\x69\x6e\x67\x20\x6c\x69\x73\x74\x2e\x0a\x66\x75\x6e\x63\x20\x68\x61\x73\x4e\x61\x6d\x65\x28\x6c\x69\x73\x74\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x6e\x61\x6d\x65\x73\x20\x2e\x2e\x2e\x73\x74\x72\x69\x6e\x67\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x0a\x09\x66\x6f\x72\x20\x5f\x2c\x20\x69\x74\x65\x6d\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x6c\x69\x73\x74\x20\x7b\x0a\x09\x09\x66\x6f\x72\x20\x5f\x2c\x20\x6e\x61\x6d\x65\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x6e\x61\x6d\x65\x73\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x69\x74\x65\x6d\x20\x3d\x3d\x20\x6e\x61\x6d\x65\x20\x7b\x0a\x09\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x74\x72\x75\x65\x0a\x09\x09\x09\x7d\x0a\x09\x09\x7d\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x66\x6c\x6f\x77\x52\x75\x6e\x41\x63\x74\x69\x6f\x6e\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x69\x66\x20\x63\x2e\x4e\x41\x72\x67\x28\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x6c\x6e\x28\x22\xe2\xa1\xbf\x20\x52\x75\x6e\x20\x60\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x20\x66\x6c\x6f\x77\x20\x72\x75\x6e\x20\x64\x65\x70\x6c\x6f\x79\x2e\x66\x6c\x6f\x77\x2e\x79\x61\x6d\x6c\x60\x20\x74\x6f\x20\x65\x78\x65\x63\x75\x74\x65\x20\x74\x68\x65\x20\x73\x74\x65\x70\x73\x20\x6f\x66\x20\x61\x20\x66\x6c\x6f\x77\x20\x66\x69\x6c\x65\x2e\x22\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x7d\x0a\x0a\x09\x66\x6c\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x66\x6c\x6f\x77\x2e\x4c\x6f\x61\x64\x28\x63\x2e\x41\x72\x67\x73\x28\x29\x2e\x46\x69\x72\x73\x74\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x73\x74\x61\x74\x65\x46\x69\x6c\x65\x20\x3a\x3d\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x73\x74\x61\x74\x65\x22\x29\x0a\x09\x69\x66\x20\x73\x74\x61\x74\x65\x46\x69\x6c\x65\x20\x3d\x3d\x20\x22\x22\x20\x7b\x0a\x09\x09\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x47\x65\x74\x77\x64\x28\x29\x0a\x09\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x73\x74\x61\x74\x65\x46\x69\x6c\x65\x20\x3d\x20\x66\x6c\x6f\x77\x2e\x53\x74\x61\x74\x65\x46\x69\x6c\x65\x46\x6f\x72\x28\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x2c\x20\x66\x6c\x29\x0a\x09\x7d\x0a\x0a\x09\x62\x69\x6e\x44\x69\x72\x20\x3a\x3d\x20\x63\x2e\x53\x74\x72\x69\x6e\x67\x28\x22\x62\x69\x6e\x44\x69\x72\x22\x29\x0a\x09\x69\x66\x20\x62\x69\x6e\x44\x69\x72\x20\x3d\x3d\x20\x22\x22\x20\x7b\x0a\x09\x09\x69\x66\x20\x65\x78\x65\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x45\x78\x65\x63\x75\x74\x61\x62\x6c\x65\x28\x29\x3b\x20\x65\x72\x72\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x62\x69\x6e\x44\x69\x72\x20\x3d\x20\x66\x69\x6c\x65\x70\x61\x74\x68\x2e\x44\x69\x72\x28\x65\x78\x65\x29\x0a\x09\x09\x7d\x0a\x09\x7d\x0a\x0a\x09\x72\x75\x6e\x6e\x65\x72\x20\x3a\x3d\x20\x66\x6c\x6f\x77\x2e\x52\x75\x6e\x6e\x65\x72\x7b\x0a\x09\x09\x53\x74\x61\x74\x65\x46\x69\x6c\x65\x3a\x20\x73\x74\x61\x74\x65\x46\x69\x6c\x65\x2c\x0a\x09\x09\x52\x65\x73\x75\x6d\x65\x3a\x20\x20\x20\x20\x63\x2e\x42\x6f\x6f\x6c\x28\x22\x72\x65\x73\x75\x6d\x65\x22\x29\x2c\x0a\x09\x09\x49\x6e\x76\x6f\x6b\x65\x3a\x20\x20\x20\x20\x66\x6c\x6f\x77\x49\x6e\x76\x6f\x6b\x65\x72\x28\x66\x6c\x6f\x77\x2e\x45\x78\x65\x63\x49\x6e\x76\x6f\x6b\x65\x72\x28\x62\x69\x6e\x44\x69\x72\x2c\x20\x6e\x69\x6c\x29\x29\x2c\x0a\x09\x7d\x0a\x0a\x09\x63\x74\x78\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x2c\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x63\x74\x78\x29\x0a\x0a\x09\x63\x74\x78\x2c\x20\x65\x6e\x64\x54\x72\x61\x63\x65\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x74\x61\x72\x74\x54\x72\x61\x63\x65\x28\x63\x2c\x20\x63\x74\x78\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x65\x6e\x64\x54\x72\x61\x63\x65\x28\x29\x0a\x0a\x09\x63\x74\x78\x2c\x20\x65\x6e\x64\x50\x72\x6f\x67\x72\x65\x73\x73\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x74\x61\x72\x74\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x63\x2c\x20\x63\x74\x78\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x65\x6e\x64\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x29\x0a\x0a\x09\x73\x74\x61\x74\x65\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x72\x75\x6e\x6e\x65\x72\x2e\x52\x75\x6e\x28\x63\x74\x78\x2c\x20\x66\x6c\x29\x0a\x09\x69\x66\x20\x6a\x73\x6f\x6e\x45\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x45\x6e\x63\x6f\x64\x65\x72\x28\x6f\x73\x2e\x53\x74\x64\x6f\x75\x74\x29\x2e\x45\x6e\x63\x6f\x64\x65\x28\x73\x74\x61\x74\x65\x29\x3b\x20\x6a\x73\x6f\x6e\x45\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6a\x73\x6f\x6e\x45\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x7d\x0a\x0a\x2f\x2f\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x63\x61\x72\x72\x79\x69\x6e\x67\x20\x74\x68\x65\x20\x6c\x6f\x67\x67\x65\x72\x20\x6f\x66\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x2c\x20\x61\x73\x20\x73\x65\x74\x20\x62\x79\x20\x74\x68\x65\x0a\x2f\x2f\x20\x60\x2d\x76\x60\x2c\x20\x60\x2d\x76\x76\x60\x2c\x20\x60\x2d\x2d\x6c\x6f\x67\x2d\x6c\x65\x76\x65\x6c\x60\x20\x61\x6e\x64\x20\x60\x2d\x2d\x6c\x6f\x67\x2d\x66\x6f\x72\x6d\x61\x74\x60\x20\x66\x6c\x61\x67\x73\x2e\x0a\x66\x75\x6e\x63\x20\x77\x69\x74\x68\x4c\x6f\x67\x67\x65\x72\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x70\x61\x72\x65\x6e\x74\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x09\x76\x65\x72\x62\x6f\x73\x69\x74\x79\x20\x3a\x3d\x20\x30\x0a\x09\x69\x66\x20\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x42\x6f\x6f\x6c\x28\x22\x76\x76\x22\x29\x20\x7b\x0a\x09\x09\x76\x65\x72\x62\x6f\x73\x69\x74\x79\x20\x3d\x20\x32\x0a\x09\x7d\x20\x65\x6c\x73\x65\x20\x69\x66\x20\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x42\x6f\x6f\x6c\x28\x22\x76\x22\x29\x20\x7b\x0a\x09\x09\x76\x65\x72\x62\x6f\x73\x69\x74\x79\x20\x3d\x20\x31\x0a\x09\x7d\x0a\x0a\x09\x6c\x65\x76\x65\x6c\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x2e\x4c\x65\x76\x65\x6c\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x6c\x6f\x67\x2d\x6c\x65\x76\x65\x6c\x22\x29\x2c\x20\x76\x65\x72\x62\x6f\x73\x69\x74\x79\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x6c\x6f\x67\x67\x65\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x2e\x4e\x65\x77\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x6c\x65\x76\x65\x6c\x2c\x20\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x6c\x6f\x67\x2d\x66\x6f\x72\x6d\x61\x74\x22\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x61\x72\x65\x6e\x74\x2c\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x2e\x57\x69\x74\x68\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x6c\x6f\x67\x67\x65\x72\x29\x2c\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x2f\x2f\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x20\x72\x75\x6e\x73\x20\x74\x68\x65\x20\x40\x74\x65\x61\x72\x64\x6f\x77\x6e\x20\x68\x6f\x6f\x6b\x73\x20\x6f\x66\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x2c\x20\x6c\x6f\x67\x67\x69\x6e\x67\x20\x74\x68\x65\x69\x72\x20\x66\x61\x69\x6c\x75\x72\x65\x2e\x0a\x66\x75\x6e\x63\x20\x74\x65\x61\x72\x64\x6f\x77\x6e\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x7b\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x54\x65\x61\x72\x64\x6f\x77\x6e\x28\x63\x74\x78\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x2e\x46\x72\x6f\x6d\x28\x63\x74\x78\x29\x2e\x45\x72\x72\x6f\x72\x28\x22\x54\x65\x61\x72\x64\x6f\x77\x6e\x20\x66\x61\x69\x6c\x65\x64\x22\x2c\x20\x22\x65\x72\x72\x6f\x72\x22\x2c\x20\x65\x72\x72\x29\x0a\x09\x7d\x0a\x7d\x0a\x0a\x2f\x2f\x20\x73\x74\x61\x72\x74\x50\x72\x6f\x67\x72\x65\x73\x73\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x63\x61\x72\x72\x79\x69\x6e\x67\x20\x74\x68\x65\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x20\x72\x65\x70\x6f\x72\x74\x65\x72\x20\x6f\x66\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x2c\x20\x61\x73\x0a\x2f\x2f\x20\x73\x65\x74\x20\x62\x79\x20\x74\x68\x65\x20\x60\x2d\x2d\x70\x72\x6f\x67\x72\x65\x73\x73\x60\x20\x66\x6c\x61\x67\x2c\x20\x61\x6e\x64\x20\x61\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x77\x68\x69\x63\x68\x20\x73\x74\x6f\x70\x73\x20\x69\x74\x2e\x0a\x66\x75\x6e\x63\x20\x73\x74\x61\x72\x74\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x70\x61\x72\x65\x6e\x74\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x66\x75\x6e\x63\x28\x29\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x09\x72\x65\x70\x6f\x72\x74\x65\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x2e\x4e\x65\x77\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x2e\x52\x65\x73\x6f\x6c\x76\x65\x28\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x29\x2c\x20\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x29\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x61\x72\x65\x6e\x74\x2c\x20\x6e\x69\x6c\x2c\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x2e\x57\x69\x74\x68\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x72\x65\x70\x6f\x72\x74\x65\x72\x29\x2c\x20\x72\x65\x70\x6f\x72\x74\x65\x72\x2e\x43\x6c\x6f\x73\x65\x2c\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x2f\x2f\x20\x73\x74\x61\x72\x74\x54\x72\x61\x63\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x77\x68\x69\x63\x68\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x73\x70\x61\x6e\x73\x20\x69\x66\x20\x74\x68\x65\x20\x60\x2d\x2d\x74\x72\x61\x63\x65\x60\x20\x66\x6c\x61\x67\x20\x69\x73\x20\x73\x65\x74\x2c\x0a\x2f\x2f\x20\x61\x6e\x64\x20\x61\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x77\x68\x69\x63\x68\x20\x77\x72\x69\x74\x65\x73\x20\x74\x68\x65\x6d\x20\x69\x6e\x74\x6f\x20\x69\x74\x73\x20\x66\x69\x6c\x65\x2e\x0a\x66\x75\x6e\x63\x20\x73\x74\x61\x72\x74\x54\x72\x61\x63\x65\x28\x63\x20\x2a\x63\x6c\x69\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x70\x61\x72\x65\x6e\x74\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x66\x75\x6e\x63\x28\x29\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x09\x70\x61\x74\x68\x2c\x20\x66\x6f\x72\x6d\x61\x74\x20\x3a\x3d\x20\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x72\x61\x63\x65\x22\x29\x2c\x20\x63\x2e\x47\x6c\x6f\x62\x61\x6c\x53\x74\x72\x69\x6e\x67\x28\x22\x74\x72\x61\x63\x65\x2d\x66\x6f\x72\x6d\x61\x74\x22\x29\x0a\x09\x69\x66\x20\x70\x61\x74\x68\x20\x3d\x3d\x20\x22\x22\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x61\x72\x65\x6e\x74\x2c\x20\x66\x75\x6e\x63\x28\x29\x20\x7b\x7d\x2c\x20\x6e\x69\x6c\x0a\x09\x7d\x0a\x0a\x09\x6f\x77\x6e\x65\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x68\x61\x72\x65\x28\x70\x61\x74\x68\x2c\x20\x66\x6f\x72\x6d\x61\x74\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x61\x72\x65\x6e\x74\x2c\x20\x6e\x69\x6c\x2c\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x74\x72\x61\x63\x65\x72\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x4e\x65\x77\x28\x6f\x73\x2e\x47\x65\x74\x70\x69\x64\x28\x29\x29\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x74\x72\x61\x63\x65\x2e\x57\x69\x74\x68\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x74\x72\x61\x63\x65\x72\x29\x2c\x20\x66\x75\x6e\x63\x28\x29\x20\x7b\x0a\x09\x09\x65\x72\x72\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x57\x72\x69\x74\x65\x28\x70\x61\x74\x68\x2c\x20\x66\x6f\x72\x6d\x61\x74\x2c\x20\x74\x72\x61\x63\x65\x72\x2e\x53\x70\x61\x6e\x73\x28\x29\x29\x0a\x09\x09\x69\x66\x20\x65\x72\x72\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x6f\x77\x6e\x65\x72\x20\x7b\x0a\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x74\x72\x61\x63\x65\x2e\x46\x69\x6e\x69\x73\x68\x28\x70\x61\x74\x68\x2c\x20\x66\x6f\x72\x6d\x61\x74\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x66\x6d\x74\x2e\x46\x70\x72\x69\x6e\x74\x66\x28\x6f\x73\x2e\x53\x74\x64\x65\x72\x72\x2c\x20\x22\xe2\xa1\xbf\x20\x46\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x77\x72\x69\x74\x65\x20\x74\x72\x61\x63\x65\x3a\x20\x25\x73\x5c\x6e\x22\x2c\x20\x65\x72\x72\x29\x0a\x09\x09\x7d\x0a\x09\x7d\x2c\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x2f\x2f\x20\x63\x61\x6c\x6c\x65\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x73\x65\x72\x76\x65\x2e\x43\x61\x6c\x6c\x65\x72\x20\x77\x68\x69\x63\x68\x20\x63\x61\x6c\x6c\x73\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x0a\x2f\x2f\x20\x77\x69\x74\x68\x20\x67\x69\x76\x69\x6e\x67\x20\x74\x69\x6d\x65\x6f\x75\x74\x2e\x0a\x66\x75\x6e\x63\x20\x63\x61\x6c\x6c\x65\x72\x28\x74\x69\x6d\x65\x6f\x75\x74\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x29\x20\x73\x65\x72\x76\x65\x2e\x43\x61\x6c\x6c\x65\x72\x20\x7b\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x66\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x2c\x20\x61\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6c\x61\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x69\x6e\x70\x75\x74\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x6f\x75\x74\x70\x75\x74\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x63\x6d\x64\x20\x3a\x3d\x20\x66\x6e\x2e\x4e\x53\x0a\x09\x09\x69\x66\x20\x66\x6e\x2e\x42\x69\x6e\x61\x72\x79\x20\x21\x3d\x20\x62\x69\x6e\x4e\x61\x6d\x65\x20\x7b\x0a\x09\x09\x09\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x20\x3d\x20\x66\x6e\x2e\x42\x69\x6e\x61\x72\x79\x2c\x20\x61\x70\x70\x65\x6e\x64\x28\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x66\x6e\x2e\x4e\x53\x7d\x2c\x20\x61\x72\x67\x73\x2e\x2e\x2e\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x63\x74\x78\x2c\x20\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x2c\x20\x66\x6c\x61\x67\x73\x2c\x20\x69\x6e\x70\x75\x74\x2c\x20\x6f\x75\x74\x70\x75\x74\x2c\x20\x74\x69\x6d\x65\x6f\x75\x74\x29\x0a\x09\x7d\x0a\x7d\x0a\x0a\x2f\x2f\x20\x66\x6c\x6f\x77\x49\x6e\x76\x6f\x6b\x65\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x66\x6c\x6f\x77\x2e\x49\x6e\x76\x6f\x6b\x65\x72\x20\x77\x68\x69\x63\x68\x20\x63\x61\x6c\x6c\x73\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x62\x75\x6e\x64\x6c\x65\x64\x20\x77\x69\x74\x68\x69\x6e\x20\x74\x68\x69\x73\x0a\x2f\x2f\x20\x62\x69\x6e\x61\x72\x79\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x61\x6e\x64\x20\x64\x65\x66\x65\x72\x73\x20\x74\x6f\x20\x6f\x74\x68\x65\x72\x73\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x72\x65\x73\x74\x2e\x0a\x66\x75\x6e\x63\x20\x66\x6c\x6f\x77\x49\x6e\x76\x6f\x6b\x65\x72\x28\x6f\x74\x68\x65\x72\x73\x20\x66\x6c\x6f\x77\x2e\x49\x6e\x76\x6f\x6b\x65\x72\x29\x20\x66\x6c\x6f\x77\x2e\x49\x6e\x76\x6f\x6b\x65\x72\x20\x7b\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x63\x61\x6c\x6c\x20\x66\x6c\x6f\x77\x2e\x43\x61\x6c\x6c\x2c\x20\x69\x6e\x70\x75\x74\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x6f\x75\x74\x70\x75\x74\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x20\x3a\x3d\x20\x63\x61\x6c\x6c\x2e\x46\x6e\x2c\x20\x63\x61\x6c\x6c\x2e\x41\x72\x67\x73\x0a\x0a\x09\x09\x69\x66\x20\x63\x61\x6c\x6c\x2e\x42\x69\x6e\x20\x21\x3d\x20\x22\x22\x20\x26\x26\x20\x63\x61\x6c\x6c\x2e\x42\x69\x6e\x20\x21\x3d\x20\x62\x69\x6e\x4e\x61\x6d\x65\x20\x7b\x0a\x09\x09\x09\x6d\x65\x74\x61\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x63\x61\x6c\x6c\x2e\x42\x69\x6e\x2c\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x63\x61\x6c\x6c\x2e\x46\x6e\x7d\x29\x0a\x09\x09\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7c\x7c\x20\x28\x6d\x65\x74\x61\x2e\x4e\x53\x20\x21\x3d\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x54\x6f\x4c\x6f\x77\x65\x72\x28\x63\x61\x6c\x6c\x2e\x46\x6e\x29\x20\x26\x26\x20\x6d\x65\x74\x61\x2e\x4e\x61\x6d\x65\x20\x21\x3d\x20\x63\x61\x6c\x6c\x2e\x46\x6e\x29\x20\x7b\x0a\x09\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6f\x74\x68\x65\x72\x73\x28\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x2c\x20\x69\x6e\x70\x75\x74\x2c\x20\x6f\x75\x74\x70\x75\x74\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x20\x3d\x20\x63\x61\x6c\x6c\x2e\x42\x69\x6e\x2c\x20\x61\x70\x70\x65\x6e\x64\x28\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x63\x61\x6c\x6c\x2e\x46\x6e\x7d\x2c\x20\x63\x61\x6c\x6c\x2e\x41\x72\x67\x73\x2e\x2e\x2e\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x76\x61\x72\x20\x74\x69\x6d\x65\x6f\x75\x74\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x0a\x09\x09\x69\x66\x20\x64\x65\x61\x64\x6c\x69\x6e\x65\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x63\x74\x78\x2e\x44\x65\x61\x64\x6c\x69\x6e\x65\x28\x29\x3b\x20\x6f\x6b\x20\x7b\x0a\x09\x09\x09\x74\x69\x6d\x65\x6f\x75\x74\x20\x3d\x20\x74\x69\x6d\x65\x2e\x55\x6e\x74\x69\x6c\x28\x64\x65\x61\x64\x6c\x69\x6e\x65\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x66\x6c\x61\x67\x73\x2c\x20\x5f\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x69\x6c\x74\x65\x72\x46\x6c\x61\x67\x73\x28\x63\x61\x6c\x6c\x2e\x46\x6c\x61\x67\x73\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x70\x6b\x67\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x63\x74\x78\x2c\x20\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x2c\x20\x66\x6c\x61\x67\x73\x2c\x20\x69\x6e\x70\x75\x74\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x6f\x75\x74\x70\x75\x74\x7d\x2c\x20\x74\x69\x6d\x65\x6f\x75\x74\x29\x0a\x09\x7d\x0a\x7d\x0a\x0a\x74\x79\x70\x65\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x20\x73\x74\x72\x75\x63\x74\x7b\x0a\x09\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x0a\x7d\x0a\x0a\x2f\x2f\x20\x43\x6c\x6f\x73\x65\x20\x64\x6f\x65\x73\x20\x6e\x6f\x74\x68\x69\x6e\x67\x2e\x0a\x66\x75\x6e\x63\x20\x28\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x29\x20\x43\x6c\x6f\x73\x65\x28\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x2f\x2f\x20\x74\x61\x6b\x65\x73\x4a\x53\x4f\x4e\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x72\x75\x65\x2f\x66\x61\x6c\x73\x65\x20\x69\x66\x20\x67\x69\x76\x69\x6e\x67\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x64\x65\x63\x6f\x64\x65\x73\x20\x69\x74\x73\x20\x69\x6e\x70\x75\x74\x20\x61\x73\x20\x4a\x53\x4f\x4e\x2e\x0a\x66\x75\x6e\x63\x20\x74\x61\x6b\x65\x73\x4a\x53\x4f\x4e\x28\x66\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x0a\x09\x6b\x69\x6e\x64\x20\x3a\x3d\x20\x75\x69\x2e\x49\x6e\x70\x75\x74\x4f\x66\x28\x66\x6e\x29\x2e\x4b\x69\x6e\x64\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6b\x69\x6e\x64\x20\x3d\x3d\x20\x75\x69\x2e\x49\x6e\x70\x75\x74\x53\x74\x72\x75\x63\x74\x20\x7c\x7c\x20\x6b\x69\x6e\x64\x20\x3d\x3d\x20\x75\x69\x2e\x49\x6e\x70\x75\x74\x4a\x53\x4f\x4e\x0a\x7d\x0a\x0a\x2f\x2f\x20\x61\x73\x73\x69\x67\x6e\x65\x64\x49\x6e\x70\x75\x74\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x4a\x53\x4f\x4e\x20\x6f\x66\x20\x67\x69\x76\x69\x6e\x67\x20\x61\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x20\x61\x70\x70\x6c\x69\x65\x64\x20\x74\x6f\x20\x74\x68\x65\x20\x4a\x53\x4f\x4e\x20\x6f\x62\x6a\x65\x63\x74\x0a\x2f\x2f\x20\x72\x65\x61\x64\x20\x66\x72\x6f\x6d\x20\x69\x6e\x70\x75\x74\x2c\x20\x77\x68\x69\x63\x68\x20\x69\x73\x20\x6f\x6e\x6c\x79\x20\x72\x65\x61\x64\x20\x77\x68\x65\x6e\x20\x69\x74\x27\x73\x20\x6e\x6f\x74\x20\x61\x20\x74\x65\x72\x6d\x69\x6e\x61\x6c\x2e\x0a\x66\x75\x6e\x63\x20\x61\x73\x73\x69\x67\x6e\x65\x64\x49\x6e\x70\x75\x74\x28\x69\x6e\x70\x75\x74\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x61\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x09\x76\x61\x72\x20\x62\x61\x73\x65\x20\x5b\x5d\x62\x79\x74\x65\x0a\x09\x69\x66\x20\x73\x74\x61\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x53\x74\x64\x69\x6e\x2e\x53\x74\x61\x74\x28\x29\x3b\x20\x65\x72\x72\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x73\x74\x61\x74\x2e\x4d\x6f\x64\x65\x28\x29\x26\x6f\x73\x2e\x4d\x6f\x64\x65\x43\x68\x61\x72\x44\x65\x76\x69\x63\x65\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x64\x61\x74\x61\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6f\x2e\x52\x65\x61\x64\x41\x6c\x6c\x28\x69\x6e\x70\x75\x74\x29\x0a\x09\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x70\x75\x74\x2c\x20\x65\x72\x72\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x62\x61\x73\x65\x20\x3d\x20\x64\x61\x74\x61\x0a\x09\x7d\x0a\x0a\x09\x70\x61\x79\x6c\x6f\x61\x64\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x41\x73\x73\x69\x67\x6e\x28\x62\x61\x73\x65\x2c\x20\x61\x73\x73\x69\x67\x6e\x6d\x65\x6e\x74\x73\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x70\x75\x74\x2c\x20\x65\x72\x72\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x62\x79\x74\x65\x73\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x70\x61\x79\x6c\x6f\x61\x64\x29\x2c\x20\x6e\x69\x6c\x0a\x7d\x0a\x0a\x2f\x2f\x20\x73\x74\x64\x69\x6e\x48\x61\x73\x44\x61\x74\x61\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x72\x75\x65\x2f\x66\x61\x6c\x73\x65\x20\x69\x66\x20\x64\x61\x74\x61\x20\x69\x73\x20\x61\x6c\x6c\x6f\x63\x61\x74\x65\x64\x20\x69\x6e\x74\x6f\x20\x73\x74\x64\x69\x6e\x2e\x0a\x66\x75\x6e\x63\x20\x73\x74\x64\x69\x6e\x48\x61\x73\x44\x61\x74\x61\x28\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x0a\x09\x73\x74\x61\x74\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x53\x74\x64\x69\x6e\x2e\x53\x74\x61\x74\x28\x29\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x73\x74\x61\x74\x2e\x53\x69\x7a\x65\x28\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x74\x72\x75\x65\x0a\x7d\x0a")
 	files["shogun-src-pkg-test.tml"] = []byte("\x70\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x2e\x4d\x61\x69\x6e\x2e\x50\x6b\x67\x4e\x61\x6d\x65\x7d\x7d\x5f\x74\x65\x73\x74\x0a\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0a\x09\x22\x62\x79\x74\x65\x73\x22\x0a\x09\x22\x63\x6f\x6e\x74\x65\x78\x74\x22\x0a\x09\x22\x65\x6e\x63\x6f\x64\x69\x6e\x67\x2f\x6a\x73\x6f\x6e\x22\x0a\x09\x22\x66\x6d\x74\x22\x0a\x09\x22\x69\x6f\x22\x0a\x09\x22\x74\x65\x73\x74\x69\x6e\x67\x22\x0a\x0a\x09\x70\x6b\x67\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x50\x6b\x67\x50\x61\x74\x68\x7d\x7d\x0a\x09\x22\x63\x6f\x6e\x74\x65\x78\x74\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x66\x61\x75\x78\x2f\x74\x65\x73\x74\x73\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x22\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x6b\x65\x6e\x73\x68\x6f\x22\x0a\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x70\x61\x74\x68\x2c\x20\x24\x6e\x69\x63\x6b\x20\x3a\x3d\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x20\x7d\x7d\x0a\x20\x20\x7b\x7b\x24\x6e\x69\x63\x6b\x7d\x7d\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x70\x61\x74\x68\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x29\x0a\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x65\x6c\x65\x6d\x2e\x4c\x69\x73\x74\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x41\x72\x67\x75\x6d\x65\x6e\x74\x53\x74\x72\x75\x63\x74\x55\x6e\x65\x78\x70\x6f\x72\x74\x65\x64\x20\x2e\x53\x74\x72\x75\x63\x74\x45\x78\x70\x6f\x72\x74\x65\x64\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x09\x74\x65\x73\x74\x73\x2e\x45\x72\x72\x6f\x72\x65\x64\x28\x60\x55\x6e\x61\x62\x6c\x65\x20\x74\x6f\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x74\x65\x73\x74\x73\x20\x66\x6f\x72\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x20\x64\x75\x65\x20\x74\x6f\x20\x75\x6e\x65\x78\x70\x6f\x72\x74\x65\x64\x20\x61\x72\x67\x75\x6d\x65\x6e\x74\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x60\x29\x0a\x7d\x0a\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x4e\x6f\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x6b\x65\x6e\x73\x68\x6f\x2e\x54\x65\x73\x74\x4e\x6f\x41\x72\x67\x75\x6d\x65\x6e\x74\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x43\x6f\x6e\x74\x65\x78\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x6b\x65\x6e\x73\x68\x6f\x2e\x54\x65\x73\x74\x4e\x6f\x41\x72\x67\x75\x6d\x65\x6e\x74\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x6b\x65\x6e\x73\x68\x6f\x2e\x54\x65\x73\x74\x53\x74\x72\x69\x6e\x67\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x6b\x65\x6e\x73\x68\x6f\x2e\x54\x65\x73\x74\x4d\x61\x70\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x6b\x65\x6e\x73\x68\x6f\x2e\x54\x65\x73\x74\x52\x65\x61\x64\x65\x72\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x57\x72\x69\x74\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x6b\x65\x6e\x73\x68\x6f\x2e\x54\x65\x73\x74\x57\x72\x69\x74\x65\x72\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x6b\x65\x6e\x73\x68\x6f\x2e\x54\x65\x73\x74\x53\x74\x72\x69\x6e\x67\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x6b\x65\x6e\x73\x68\x6f\x2e\x54\x65\x73\x74\x52\x65\x61\x64\x65\x72\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x6b\x65\x6e\x73\x68\x6f\x2e\x54\x65\x73\x74\x4d\x61\x70\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x74\x65\x73\x74\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x74\x65\x73\x74\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x66\x75\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x29\x20\x7b\x0a\x09\x76\x61\x72\x20\x65\x72\x72\x20\x65\x72\x72\x6f\x72\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x20\x7b\x0a\x09\x09\x69\x66\x20\x72\x65\x63\x20\x3a\x3d\x20\x72\x65\x63\x6f\x76\x65\x72\x28\x29\x3b\x20\x72\x65\x63\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x73\x77\x69\x74\x63\x68\x20\x64\x72\x65\x63\x20\x3a\x3d\x20\x72\x65\x63\x2e\x28\x74\x79\x70\x65\x29\x20\x7b\x0a\x09\x09\x09\x63\x61\x73\x65\x20\x65\x72\x72\x6f\x72\x3a\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x64\x72\x65\x63\x0a\x09\x09\x09\x64\x65\x66\x61\x75\x6c\x74\x3a\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x52\x65\x63\x6f\x76\x65\x72\x20\x45\x72\x72\x6f\x72\x3a\x20\x25\x2b\x71\x22\x2c\x20\x72\x65\x63\x29\x0a\x09\x09\x09\x7d\x0a\x09\x09\x7d\x0a\x09\x7d\x28\x29\x0a\x0a\x09\x76\x61\x72\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x09\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2e\x57\x72\x69\x74\x65\x53\x74\x72\x69\x6e\x67\x28\x60\x7b\x22\x6e\x61\x6d\x65\x22\x3a\x22\x52\x6f\x63\x6b\x22\x7d\x60\x29\x0a\x0a\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x29\x0a\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x29\x0a\x09\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x29\x0a\x09\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x29\x0a\x0a\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x09\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x09\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x0a\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x09\x69\x66\x20\x6a\x73\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x26\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x6a\x73\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x53\x74\x72\x69\x6e\x67\x4f\x6e\x6c\x79\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x3a\x20\x25\x2b\x71\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x2c\x20\x6a\x73\x65\x72\x72\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x0a\x09\x7d\x0a\x0a\x09\x73\x77\x69\x74\x63\x68\x20\x66\x75\x6e\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7b\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x7d\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x55\x73\x65\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x65\x72\x72\x20\x3d\x20\x65\x78\x65\x63\x57\x69\x74\x68\x43\x6f\x6e\x74\x65\x78\x74\x28\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x09\x7d\x2c\x20\x30\x29\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x55\x73\x65\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x65\x72\x72\x20\x3d\x20\x65\x78\x65\x63\x57\x69\x74\x68\x43\x6f\x6e\x74\x65\x78\x74\x28\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x7d\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x09\x7d\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x09\x7d\x2c\x20\x30\x29\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x53\x74\x72\x75\x63\x74\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x3a\x20\x25\x2b\x71\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x2c\x20\x65\x72\x72\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x0a\x09\x7d\x0a\x0a\x09\x74\x65\x73\x74\x73\x2e\x50\x61\x73\x73\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x70\x61\x73\x73\x65\x73\x20\x53\x74\x72\x75\x63\x74\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x29\x0a\x09\x72\x65\x74\x75\x72\x6e\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x74\x65\x73\x74\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x74\x65\x73\x74\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x66\x75\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x29\x20\x7b\x0a\x09\x76\x61\x72\x20\x65\x72\x72\x20\x65\x72\x72\x6f\x72\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x20\x7b\x0a\x09\x09\x69\x66\x20\x72\x65\x63\x20\x3a\x3d\x20\x72\x65\x63\x6f\x76\x65\x72\x28\x29\x3b\x20\x72\x65\x63\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x73\x77\x69\x74\x63\x68\x20\x64\x72\x65\x63\x20\x3a\x3d\x20\x72\x65\x63\x2e\x28\x74\x79\x70\x65\x29\x20\x7b\x0a\x09\x09\x09\x63\x61\x73\x65\x20\x65\x72\x72\x6f\x72\x3a\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x64\x72\x65\x63\x0a\x09\x09\x09\x64\x65\x66\x61\x75\x6c\x74\x3a\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x52\x65\x63\x6f\x76\x65\x72\x20\x45\x72\x72\x6f\x72\x3a\x20\x25\x2b\x71\x22\x2c\x20\x72\x65\x63\x29\x0a\x09\x09\x09\x7d\x0a\x09\x09\x7d\x0a\x09\x7d\x28\x29\x0a\x0a\x09\x76\x61\x72\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x09\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2e\x57\x72\x69\x74\x65\x53\x74\x72\x69\x6e\x67\x28\x60\x7b\x22\x6e\x61\x6d\x65\x22\x3a\x22\x52\x6f\x63\x6b\x22\x7d\x60\x29\x0a\x0a\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x29\x0a\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x29\x0a\x09\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x29\x0a\x09\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x29\x0a\x0a\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x09\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x09\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x0a\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x09\x69\x66\x20\x6a\x73\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x26\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x6a\x73\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x53\x74\x72\x69\x6e\x67\x4f\x6e\x6c\x79\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x3a\x20\x25\x2b\x71\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x2c\x20\x6a\x73\x65\x72\x72\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x0a\x09\x7d\x0a\x0a\x09\x73\x77\x69\x74\x63\x68\x20\x66\x75\x6e\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7b\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x7d\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x55\x73\x65\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x65\x72\x72\x20\x3d\x20\x65\x78\x65\x63\x57\x69\x74\x68\x43\x6f\x6e\x74\x65\x78\x74\x28\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x09\x7d\x2c\x20\x30\x29\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x55\x73\x65\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x65\x72\x72\x20\x3d\x20\x65\x78\x65\x63\x57\x69\x74\x68\x43\x6f\x6e\x74\x65\x78\x74\x28\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x09\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x09\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x09\x7d\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x09\x09\x09\x09\x7d\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x09\x7d\x2c\x20\x30\x29\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x53\x74\x72\x75\x63\x74\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x3a\x20\x25\x2b\x71\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x2c\x20\x65\x72\x72\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x0a\x09\x7d\x0a\x0a\x09\x74\x65\x73\x74\x73\x2e\x50\x61\x73\x73\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x70\x61\x73\x73\x65\x73\x20\x53\x74\x72\x75\x63\x74\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x29\x0a\x09\x72\x65\x74\x75\x72\x6e\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x74\x65\x73\x74\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x74\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x66\x75\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x29\x20\x7b\x0a\x09\x76\x61\x72\x20\x65\x72\x72\x20\x65\x72\x72\x6f\x72\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x20\x7b\x0a\x09\x09\x69\x66\x20\x72\x65\x63\x20\x3a\x3d\x20\x72\x65\x63\x6f\x76\x65\x72\x28\x29\x3b\x20\x72\x65\x63\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x73\x77\x69\x74\x63\x68\x20\x64\x72\x65\x63\x20\x3a\x3d\x20\x72\x65\x63\x2e\x28\x74\x79\x70\x65\x29\x20\x7b\x0a\x09\x09\x09\x63\x61\x73\x65\x20\x65\x72\x72\x6f\x72\x3a\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x64\x72\x65\x63\x0a\x09\x09\x09\x64\x65\x66\x61\x75\x6c\x74\x3a\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x52\x65\x63\x6f\x76\x65\x72\x20\x45\x72\x72\x6f\x72\x3a\x20\x25\x2b\x71\x22\x2c\x20\x72\x65\x63\x29\x0a\x09\x09\x09\x7d\x0a\x09\x09\x7d\x0a\x09\x7d\x28\x29\x0a\x0a\x09\x76\x61\x72\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x09\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2e\x57\x72\x69\x74\x65\x53\x74\x72\x69\x6e\x67\x28\x60\x7b\x22\x6e\x61\x6d\x65\x22\x3a\x22\x52\x6f\x63\x6b\x22\x7d\x60\x29\x0a\x0a\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x29\x0a\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x29\x0a\x09\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x29\x0a\x09\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x29\x0a\x0a\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x09\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x09\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x0a\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x09\x69\x66\x20\x6a\x73\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x26\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x6a\x73\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x53\x74\x72\x69\x6e\x67\x4f\x6e\x6c\x79\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x3a\x20\x25\x2b\x71\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x2c\x20\x6a\x73\x65\x72\x72\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x0a\x09\x7d\x0a\x0a\x09\x73\x77\x69\x74\x63\x68\x20\x66\x75\x6e\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7b\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x7d\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x55\x73\x65\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x65\x72\x72\x20\x3d\x20\x65\x78\x65\x63\x57\x69\x74\x68\x43\x6f\x6e\x74\x65\x78\x74\x28\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x09\x7d\x2c\x20\x30\x29\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x55\x73\x65\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x65\x72\x72\x20\x3d\x20\x65\x78\x65\x63\x57\x69\x74\x68\x43\x6f\x6e\x74\x65\x78\x74\x28\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x09\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x09\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x09\x09\x7d\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x09\x69\x66\x20\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x43\x6e\x46\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x09\x09\x7d\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x09\x7d\x2c\x20\x30\x29\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x53\x74\x72\x69\x6e\x67\x4f\x6e\x6c\x79\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x3a\x20\x25\x2b\x71\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x2c\x20\x65\x72\x72\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2e\x4c\x65\x6e\x28\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x73\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x72\x65\x73\x70\x6f\x6e\x64\x65\x64\x20\x77\x69\x74\x68\x20\x6f\x75\x74\x70\x75\x74\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x29\x0a\x09\x7d\x0a\x0a\x09\x74\x65\x73\x74\x73\x2e\x50\x61\x73\x73\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x70\x61\x73\x73\x65\x73\x20\x53\x74\x72\x69\x6e\x67\x4f\x6e\x6c\x79\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x29\x0a\x09\x72\x65\x74\x75\x72\x6e\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x7b\x0a\x20\x20\x74\x65\x73\x74\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x70\x6b\x67\x2e\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x74\x65\x73\x74\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x66\x75\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x29\x20\x7b\x0a\x09\x76\x61\x72\x20\x65\x72\x72\x20\x65\x72\x72\x6f\x72\x0a\x0a\x09\x64\x65\x66\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x20\x7b\x0a\x09\x09\x69\x66\x20\x72\x65\x63\x20\x3a\x3d\x20\x72\x65\x63\x6f\x76\x65\x72\x28\x29\x3b\x20\x72\x65\x63\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x73\x77\x69\x74\x63\x68\x20\x64\x72\x65\x63\x20\x3a\x3d\x20\x72\x65\x63\x2e\x28\x74\x79\x70\x65\x29\x20\x7b\x0a\x09\x09\x09\x63\x61\x73\x65\x20\x65\x72\x72\x6f\x72\x3a\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x64\x72\x65\x63\x0a\x09\x09\x09\x64\x65\x66\x61\x75\x6c\x74\x3a\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x52\x65\x63\x6f\x76\x65\x72\x20\x45\x72\x72\x6f\x72\x3a\x20\x25\x2b\x71\x22\x2c\x20\x72\x65\x63\x29\x0a\x09\x09\x09\x7d\x0a\x09\x09\x7d\x0a\x09\x7d\x28\x29\x0a\x0a\x09\x76\x61\x72\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x09\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2e\x57\x72\x69\x74\x65\x53\x74\x72\x69\x6e\x67\x28\x60\x7b\x22\x6e\x61\x6d\x65\x22\x3a\x22\x52\x6f\x63\x6b\x22\x7d\x60\x29\x0a\x0a\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x29\x0a\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x29\x0a\x0a\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x28\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x29\x0a\x0a\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x09\x69\x66\x20\x6a\x73\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x26\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x6a\x73\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x53\x74\x72\x69\x6e\x67\x4f\x6e\x6c\x79\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x3a\x20\x25\x2b\x71\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x2c\x20\x6a\x73\x65\x72\x72\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x0a\x09\x7d\x0a\x0a\x09\x73\x77\x69\x74\x63\x68\x20\x66\x75\x6e\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7b\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x72\x65\x61\x6c\x46\x75\x6e\x63\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x7d\x0a\x09\x63\x61\x73\x65\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x55\x73\x65\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x3a\x0a\x09\x09\x65\x72\x72\x20\x3d\x20\x65\x78\x65\x63\x57\x69\x74\x68\x43\x6f\x6e\x74\x65\x78\x74\x28\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x6f\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x69\x66\x20\x66\x75\x6e\x2e\x52\x65\x74\x75\x72\x6e\x20\x3d\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x45\x72\x72\x6f\x72\x52\x65\x74\x75\x72\x6e\x20\x7b\x0a\x09\x09\x09\x09\x65\x72\x72\x20\x3d\x20\x72\x65\x61\x6c\x47\x43\x74\x78\x46\x75\x6e\x63\x57\x69\x74\x68\x52\x65\x74\x75\x72\x6e\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x7b\x57\x72\x69\x74\x65\x72\x3a\x20\x26\x6f\x75\x74\x67\x6f\x69\x6e\x67\x7d\x29\x0a\x09\x09\x09\x7d\x0a\x0a\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x09\x09\x7d\x2c\x20\x30\x29\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x53\x74\x72\x69\x6e\x67\x4f\x6e\x6c\x79\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x3a\x20\x25\x2b\x71\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x2c\x20\x65\x72\x72\x29\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x0a\x09\x7d\x0a\x0a\x09\x69\x66\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2e\x4c\x65\x6e\x28\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x73\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x72\x65\x73\x70\x6f\x6e\x64\x65\x64\x20\x77\x69\x74\x68\x20\x6f\x75\x74\x70\x75\x74\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x29\x0a\x09\x7d\x0a\x0a\x09\x74\x65\x73\x74\x73\x2e\x50\x61\x73\x73\x65\x64\x28\x22\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x25\x71\x20\x77\x69\x74\x68\x20\x61\x6c\x69\x61\x73\x20\x25\x71\x20\x70\x61\x73\x73\x65\x73\x20\x53\x74\x72\x69\x6e\x67\x4f\x6e\x6c\x79\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x72\x69\x74\x65\x72\x69\x61\x73\x22\x2c\x20\x66\x75\x6e\x2e\x4e\x61\x6d\x65\x2c\x20\x66\x75\x6e\x2e\x4e\x53\x29\x0a\x09\x72\x65\x74\x75\x72\x6e\x0a\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x66\x75\x6e\x63\x20\x65\x78\x65\x63\x57\x69\x74\x68\x43\x6f\x6e\x74\x65\x78\x74\x28\x66\x75\x6e\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x73\x77\x69\x74\x63\x68\x20\x64\x66\x75\x6e\x63\x20\x3a\x3d\x20\x66\x75\x6e\x2e\x28\x74\x79\x70\x65\x29\x20\x7b\x0a\x09\x63\x61\x73\x65\x20\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x3a\x0a\x09\x09\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x09\x09\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x09\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x29\x0a\x09\x09\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x09\x09\x09\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x6e\x69\x6c\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x64\x66\x75\x6e\x63\x28\x63\x74\x78\x29\x0a\x09\x63\x61\x73\x65\x20\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x3a\x0a\x09\x09\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x09\x09\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x09\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x29\x0a\x09\x09\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x09\x09\x09\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x6e\x69\x6c\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x64\x66\x75\x6e\x63\x28\x63\x74\x78\x29\x0a\x09\x63\x61\x73\x65\x20\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x3a\x0a\x09\x09\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x09\x09\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x09\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x29\x0a\x09\x09\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x09\x09\x09\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x6e\x69\x6c\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x64\x66\x75\x6e\x63\x28\x63\x74\x78\x29\x0a\x09\x63\x61\x73\x65\x20\x66\x75\x6e\x63\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x3a\x0a\x09\x09\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x09\x09\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x09\x09\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x09\x09\x09\x63\x74\x78\x20\x3d\x20\x67\x63\x74\x78\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x09\x09\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x09\x09\x09\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x67\x63\x74\x78\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x67\x63\x74\x78\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x09\x09\x09\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x09\x09\x7d\x0a\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x64\x66\x75\x6e\x63\x28\x63\x74\x78\x29\x0a\x09\x7d\x0a\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x6f\x72\x73\x2e\x4e\x65\x77\x28\x22\x55\x6e\x6b\x6e\x6f\x77\x6e\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x74\x79\x70\x65\x22\x29\x0a\x7d\x0a\x0a\x74\x79\x70\x65\x20\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x20\x73\x74\x72\x75\x63\x74\x20\x7b\x0a\x09\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x0a\x7d\x0a\x0a\x2f\x2f\x20\x43\x6c\x6f\x73\x65\x20\x64\x6f\x65\x73\x20\x6e\x6f\x74\x68\x69\x6e\x67\x2e\x0a\x66\x75\x6e\x63\x20\x28\x77\x6f\x70\x43\x6c\x6f\x73\x65\x72\x29\x20\x43\x6c\x6f\x73\x65\x28\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a")
-	files["shogun-src-pkg.tml"] = []byte("\x2f\x2f\x20\x57\x41\x52\x4e\x49\x4e\x47\x3a\x20\x44\x6f\x20\x6e\x6f\x74\x20\x65\x64\x69\x74\x2c\x20\x74\x68\x69\x73\x20\x66\x69\x6c\x65\x20\x69\x73\x20\x61\x75\x74\x6f\x67\x65\x6e\x65\x72\x61\x74\x65\x64\x2e\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x2e\x4d\x61\x69\x6e\x2e\x50\x6b\x67\x4e\x61\x6d\x65\x7d\x7d\x0a\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0a\x20\x20\x22\x69\x6f\x22\x0a\x20\x20\x22\x74\x69\x6d\x65\x22\x0a\x20\x20\x22\x65\x6e\x63\x6f\x64\x69\x6e\x67\x2f\x6a\x73\x6f\x6e\x22\x0a\x20\x20\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x22\x0a\x7b\x7b\x20\x69\x66\x20\x2e\x4d\x61\x69\x6e\x2e\x48\x61\x73\x47\x6f\x6f\x67\x6c\x65\x49\x6d\x70\x6f\x72\x74\x73\x20\x7d\x7d\x0a\x20\x20\x22\x63\x6f\x6e\x74\x65\x78\x74\x22\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x20\x69\x66\x20\x2e\x4d\x61\x69\x6e\x2e\x48\x61\x73\x46\x61\x75\x78\x49\x6d\x70\x6f\x72\x74\x73\x20\x7d\x7d\x0a\x20\x20\x22\x63\x6f\x6e\x74\x65\x78\x74\x22\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x70\x61\x74\x68\x2c\x20\x24\x6e\x69\x63\x6b\x20\x3a\x3d\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x20\x7d\x7d\x0a\x20\x20\x7b\x7b\x24\x6e\x69\x63\x6b\x7d\x7d\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x70\x61\x74\x68\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x7b\x7b\x24\x73\x75\x62\x2e\x43\x6c\x65\x61\x6e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x73\x75\x62\x2e\x50\x6b\x67\x50\x61\x74\x68\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x29\x0a\x0a\x2f\x2f\x20\x76\x61\x72\x73\x20\x2e\x2e\x2e\x0a\x76\x61\x72\x20\x28\x0a\x20\x20\x45\x72\x72\x4e\x6f\x44\x65\x66\x61\x75\x6c\x74\x20\x3d\x20\x65\x72\x72\x6f\x72\x73\x2e\x4e\x65\x77\x28\x22\x4e\x6f\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x22\x29\x0a\x20\x20\x73\x75\x62\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x3d\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x62\x6f\x6f\x6c\x7b\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x24\x73\x75\x62\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x3a\x20\x74\x72\x75\x65\x2c\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x20\x7d\x0a\x29\x0a\x0a\x2f\x2f\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x74\x6f\x20\x70\x72\x6f\x76\x69\x64\x65\x20\x74\x65\x72\x73\x65\x20\x69\x6e\x66\x6f\x72\x6d\x61\x74\x69\x6f\x6e\x20\x77\x69\x74\x68\x0a\x2f\x2f\x20\x61\x74\x74\x61\x63\x68\x65\x64\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x6f\x72\x20\x64\x69\x73\x70\x6c\x61\x79\x69\x6e\x67\x20\x6f\x72\x20\x66\x6f\x72\x20\x74\x65\x73\x74\x69\x6e\x67\x2e\x0a\x66\x75\x6e\x63\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x63\x6d\x64\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x61\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x20\x20\x7b\x7b\x20\x69\x66\x20\x6e\x6f\x74\x65\x71\x75\x61\x6c\x20\x28\x6c\x65\x6e\x20\x2e\x53\x75\x62\x73\x29\x20\x30\x7d\x7d\x2f\x2f\x20\x49\x66\x20\x69\x74\x73\x20\x61\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x74\x68\x65\x6e\x20\x6c\x65\x74\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x68\x61\x6e\x64\x6c\x65\x20\x74\x68\x69\x73\x2e\x0a\x20\x20\x69\x66\x20\x73\x75\x62\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x5b\x63\x6d\x64\x5d\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x66\x69\x72\x73\x74\x20\x73\x74\x72\x69\x6e\x67\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x72\x65\x73\x74\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x0a\x0a\x20\x20\x20\x20\x69\x66\x20\x6c\x65\x6e\x28\x61\x72\x67\x73\x29\x20\x21\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x72\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x30\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x31\x3a\x5d\x0a\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x73\x75\x62\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x24\x73\x75\x62\x2e\x43\x6c\x65\x61\x6e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x66\x69\x72\x73\x74\x2c\x20\x72\x65\x73\x74\x29\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x65\x6c\x65\x6d\x2e\x4c\x69\x73\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x7d\x2c\x20\x65\x72\x72\x6f\x72\x73\x2e\x4e\x65\x77\x28\x22\x4e\x6f\x74\x20\x66\x6f\x75\x6e\x64\x22\x29\x0a\x7d\x0a\x0a\x2f\x2f\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x20\x65\x78\x65\x63\x75\x74\x65\x73\x20\x6e\x65\x63\x65\x73\x73\x61\x72\x79\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x61\x73\x20\x6e\x65\x65\x64\x65\x64\x20\x66\x72\x6f\x6d\x20\x69\x74\x73\x20\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x20\x61\x6e\x64\x0a\x2f\x2f\x20\x77\x72\x69\x74\x65\x73\x20\x63\x6f\x72\x72\x65\x73\x70\x6f\x6e\x64\x69\x6e\x67\x20\x6f\x75\x74\x70\x75\x74\x73\x20\x74\x6f\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x60\x6f\x75\x67\x6f\x69\x6e\x67\x60\x20\x77\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x2e\x0a\x66\x75\x6e\x63\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x28\x63\x6d\x64\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x61\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6c\x61\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x20\x20\x7b\x7b\x20\x69\x66\x20\x6e\x6f\x74\x65\x71\x75\x61\x6c\x20\x28\x6c\x65\x6e\x20\x2e\x53\x75\x62\x73\x29\x20\x30\x7d\x7d\x2f\x2f\x20\x49\x66\x20\x69\x74\x73\x20\x61\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x74\x68\x65\x6e\x20\x6c\x65\x74\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x68\x61\x6e\x64\x6c\x65\x20\x74\x68\x69\x73\x2e\x0a\x20\x20\x69\x66\x20\x73\x75\x62\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x5b\x63\x6d\x64\x5d\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x66\x69\x72\x73\x74\x20\x73\x74\x72\x69\x6e\x67\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x72\x65\x73\x74\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x0a\x0a\x20\x20\x20\x20\x69\x66\x20\x6c\x65\x6e\x28\x61\x72\x67\x73\x29\x20\x21\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x72\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x30\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x31\x3a\x5d\x0a\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x73\x75\x62\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x24\x73\x75\x62\x2e\x43\x6c\x65\x61\x6e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x28\x66\x69\x72\x73\x74\x2c\x20\x72\x65\x73\x74\x2c\x20\x66\x6c\x61\x67\x73\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x65\x6c\x65\x6d\x2e\x4c\x69\x73\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x6e\x6f\x74\x20\x28\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6d\x64\x46\x6c\x61\x67\x73\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x49\x66\x20\x66\x6c\x61\x67\x73\x20\x66\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x6c\x6f\x61\x64\x20\x74\x68\x65\x6e\x20\x63\x72\x79\x6f\x75\x74\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x63\x6d\x64\x46\x6c\x61\x67\x73\x2e\x4c\x6f\x61\x64\x28\x66\x6c\x61\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4e\x6f\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x43\x6f\x6e\x74\x65\x78\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6f\x2e\x43\x6f\x70\x79\x28\x26\x64\x61\x74\x61\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x69\x6f\x2e\x45\x4f\x46\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x53\x6c\x69\x63\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x57\x72\x69\x74\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6f\x2e\x43\x6f\x70\x79\x28\x26\x64\x61\x74\x61\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x69\x6f\x2e\x45\x4f\x46\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x53\x6c\x69\x63\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x65\x71\x75\x61\x6c\x20\x28\x6c\x65\x6e\x20\x2e\x4d\x61\x69\x6e\x2e\x44\x65\x66\x61\x75\x6c\x74\x29\x20\x30\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x45\x72\x72\x4e\x6f\x44\x65\x66\x61\x75\x6c\x74\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x44\x65\x66\x61\x75\x6c\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x6e\x6f\x74\x20\x28\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6d\x64\x46\x6c\x61\x67\x73\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x49\x66\x20\x66\x6c\x61\x67\x73\x20\x66\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x6c\x6f\x61\x64\x20\x74\x68\x65\x6e\x20\x63\x72\x79\x6f\x75\x74\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x63\x6d\x64\x46\x6c\x61\x67\x73\x2e\x4c\x6f\x61\x64\x28\x66\x6c\x61\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4e\x6f\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x43\x6f\x6e\x74\x65\x78\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6f\x2e\x43\x6f\x70\x79\x28\x26\x64\x61\x74\x61\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x69\x6f\x2e\x45\x4f\x46\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x53\x6c\x69\x63\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x57\x72\x69\x74\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6f\x2e\x43\x6f\x70\x79\x28\x26\x64\x61\x74\x61\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x69\x6f\x2e\x45\x4f\x46\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x53\x6c\x69\x63\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6a\x73\x6f\x6e\x2e\x4e\x65\x77\x44\x65\x63\x6f\x64\x65\x72\x28\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x2e\x44\x65\x63\x6f\x64\x65\x28\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x45\x78\x70\x65\x63\x74\x65\x64\x20\x56\x61\x6c\x69\x64\x20\x4a\x53\x4f\x4e\x3a\x20\x25\x2b\x71\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x7d\x0a\x7d\x0a\x0a\x2f\x2f\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x48\x65\x6c\x70\x20\x64\x69\x73\x70\x6c\x61\x79\x20\x68\x65\x6c\x70\x20\x6d\x65\x73\x73\x61\x67\x65\x20\x66\x6f\x72\x20\x65\x78\x65\x63\x75\x74\x61\x62\x6c\x65\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x61\x6e\x64\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x2e\x0a\x66\x75\x6e\x63\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x48\x65\x6c\x70\x28\x73\x6f\x75\x72\x63\x65\x20\x62\x6f\x6f\x6c\x2c\x20\x20\x63\x6d\x64\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x61\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x20\x20\x64\x65\x66\x65\x72\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2e\x43\x6c\x6f\x73\x65\x28\x29\x0a\x0a\x20\x20\x7b\x7b\x20\x69\x66\x20\x6e\x6f\x74\x65\x71\x75\x61\x6c\x20\x28\x6c\x65\x6e\x20\x2e\x53\x75\x62\x73\x29\x20\x30\x7d\x7d\x2f\x2f\x20\x49\x66\x20\x69\x74\x73\x20\x61\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x74\x68\x65\x6e\x20\x6c\x65\x74\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x68\x61\x6e\x64\x6c\x65\x20\x74\x68\x69\x73\x2e\x0a\x20\x20\x69\x66\x20\x73\x75\x62\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x5b\x63\x6d\x64\x5d\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x66\x69\x72\x73\x74\x20\x73\x74\x72\x69\x6e\x67\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x72\x65\x73\x74\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x0a\x0a\x20\x20\x20\x20\x69\x66\x20\x6c\x65\x6e\x28\x61\x72\x67\x73\x29\x20\x21\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x72\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x30\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x31\x3a\x5d\x0a\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x73\x75\x62\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x24\x73\x75\x62\x2e\x43\x6c\x65\x61\x6e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x48\x65\x6c\x70\x28\x73\x6f\x75\x72\x63\x65\x2c\x20\x66\x69\x72\x73\x74\x2c\x20\x72\x65\x73\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x65\x6c\x65\x6d\x2e\x4c\x69\x73\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x73\x6f\x75\x72\x63\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2e\x57\x72\x69\x74\x65\x28\x5b\x5d\x62\x79\x74\x65\x28\x60\x7b\x7b\x2e\x48\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x57\x69\x74\x68\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x29\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2e\x57\x72\x69\x74\x65\x28\x5b\x5d\x62\x79\x74\x65\x28\x60\x7b\x7b\x2e\x48\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x7d\x7d\x60\x29\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x20\x20\x20\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2e\x57\x72\x69\x74\x65\x28\x5b\x5d\x62\x79\x74\x65\x28\x60\x7b\x7b\x2e\x48\x65\x6c\x70\x7d\x7d\x60\x29\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a")
+	files["shogun-src-pkg.tml"] = []byte("\x2f\x2f\x20\x57\x41\x52\x4e\x49\x4e\x47\x3a\x20\x44\x6f\x20\x6e\x6f\x74\x20\x65\x64\x69\x74\x2c\x20\x74\x68\x69\x73\x20\x66\x69\x6c\x65\x20\x69\x73\x20\x61\x75\x74\x6f\x67\x65\x6e\x65\x72\x61\x74\x65\x64\x2e\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x2e\x4d\x61\x69\x6e\x2e\x50\x6b\x67\x4e\x61\x6d\x65\x7d\x7d\x0a\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0a\x20\x20\x22\x69\x6f\x22\x0a\x20\x20\x22\x74\x69\x6d\x65\x22\x0a\x20\x20\x22\x63\x6f\x6e\x74\x65\x78\x74\x22\x0a\x20\x20\x22\x65\x6e\x63\x6f\x64\x69\x6e\x67\x2f\x6a\x73\x6f\x6e\x22\x0a\x20\x20\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x22\x0a\x20\x20\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x22\x0a\x20\x20\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x73\x68\x6f\x67\x75\x6e\x2f\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2f\x74\x72\x61\x63\x65\x22\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x70\x61\x74\x68\x2c\x20\x24\x6e\x69\x63\x6b\x20\x3a\x3d\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x20\x7d\x7d\x0a\x20\x20\x7b\x7b\x24\x6e\x69\x63\x6b\x7d\x7d\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x70\x61\x74\x68\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x7b\x7b\x24\x73\x75\x62\x2e\x43\x6c\x65\x61\x6e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x73\x75\x62\x2e\x50\x6b\x67\x50\x61\x74\x68\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x29\x0a\x0a\x2f\x2f\x20\x76\x61\x72\x73\x20\x2e\x2e\x2e\x0a\x76\x61\x72\x20\x28\x0a\x20\x20\x45\x72\x72\x4e\x6f\x44\x65\x66\x61\x75\x6c\x74\x20\x3d\x20\x65\x72\x72\x6f\x72\x73\x2e\x4e\x65\x77\x28\x22\x4e\x6f\x20\x64\x65\x66\x61\x75\x6c\x74\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x22\x29\x0a\x20\x20\x73\x75\x62\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x3d\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x62\x6f\x6f\x6c\x7b\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x24\x73\x75\x62\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x3a\x20\x74\x72\x75\x65\x2c\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x20\x7d\x0a\x0a\x20\x20\x2f\x2f\x20\x68\x6f\x6f\x6b\x73\x20\x72\x75\x6e\x73\x20\x74\x68\x65\x20\x40\x73\x65\x74\x75\x70\x2c\x20\x40\x62\x65\x66\x6f\x72\x65\x2c\x20\x40\x61\x66\x74\x65\x72\x20\x61\x6e\x64\x20\x40\x74\x65\x61\x72\x64\x6f\x77\x6e\x20\x68\x6f\x6f\x6b\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x61\x72\x6f\x75\x6e\x64\x20\x69\x74\x73\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x2e\x0a\x20\x20\x68\x6f\x6f\x6b\x73\x20\x3d\x20\x26\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x48\x6f\x6f\x6b\x73\x7b\x0a\x20\x20\x20\x20\x53\x65\x74\x75\x70\x3a\x20\x5b\x5d\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4c\x69\x66\x65\x63\x79\x63\x6c\x65\x48\x6f\x6f\x6b\x7b\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x48\x6f\x6f\x6b\x73\x46\x6f\x72\x20\x22\x40\x73\x65\x74\x75\x70\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x42\x65\x66\x6f\x72\x65\x3a\x20\x5b\x5d\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x42\x65\x66\x6f\x72\x65\x48\x6f\x6f\x6b\x7b\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x48\x6f\x6f\x6b\x73\x46\x6f\x72\x20\x22\x40\x62\x65\x66\x6f\x72\x65\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x41\x66\x74\x65\x72\x3a\x20\x5b\x5d\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x41\x66\x74\x65\x72\x48\x6f\x6f\x6b\x7b\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x48\x6f\x6f\x6b\x73\x46\x6f\x72\x20\x22\x40\x61\x66\x74\x65\x72\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x54\x65\x61\x72\x64\x6f\x77\x6e\x3a\x20\x5b\x5d\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4c\x69\x66\x65\x63\x79\x63\x6c\x65\x48\x6f\x6f\x6b\x7b\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x48\x6f\x6f\x6b\x73\x46\x6f\x72\x20\x22\x40\x74\x65\x61\x72\x64\x6f\x77\x6e\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x2f\x2f\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x73\x20\x63\x6f\x6e\x74\x61\x69\x6e\x73\x20\x74\x68\x65\x20\x40\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x2c\x20\x62\x79\x20\x74\x68\x65\x20\x74\x79\x70\x65\x20\x74\x68\x65\x79\x20\x70\x72\x6f\x76\x69\x64\x65\x2e\x0a\x20\x20\x70\x72\x6f\x76\x69\x64\x65\x72\x73\x20\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x50\x72\x6f\x76\x69\x64\x65\x72\x73\x7b\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x50\x72\x6f\x76\x69\x64\x65\x72\x73\x7d\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x54\x79\x70\x65\x7d\x7d\x3a\x20\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x28\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x69\x6e\x64\x65\x78\x2c\x20\x24\x64\x65\x70\x20\x3a\x3d\x20\x2e\x44\x65\x70\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x70\x7b\x7b\x24\x69\x6e\x64\x65\x78\x7d\x7d\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x50\x72\x6f\x76\x69\x64\x65\x5b\x7b\x7b\x24\x64\x65\x70\x7d\x7d\x5d\x28\x63\x74\x78\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x64\x65\x70\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x69\x6e\x64\x65\x78\x2c\x20\x24\x5f\x20\x3a\x3d\x20\x2e\x44\x65\x70\x73\x7d\x7d\x2c\x20\x64\x65\x70\x7b\x7b\x24\x69\x6e\x64\x65\x78\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x7d\x2c\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x7d\x0a\x29\x0a\x0a\x2f\x2f\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x74\x6f\x20\x70\x72\x6f\x76\x69\x64\x65\x20\x74\x65\x72\x73\x65\x20\x69\x6e\x66\x6f\x72\x6d\x61\x74\x69\x6f\x6e\x20\x77\x69\x74\x68\x0a\x2f\x2f\x20\x61\x74\x74\x61\x63\x68\x65\x64\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x6f\x72\x20\x64\x69\x73\x70\x6c\x61\x79\x69\x6e\x67\x20\x6f\x72\x20\x66\x6f\x72\x20\x74\x65\x73\x74\x69\x6e\x67\x2e\x0a\x66\x75\x6e\x63\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x63\x6d\x64\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x61\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x20\x20\x7b\x7b\x20\x69\x66\x20\x6e\x6f\x74\x65\x71\x75\x61\x6c\x20\x28\x6c\x65\x6e\x20\x2e\x53\x75\x62\x73\x29\x20\x30\x7d\x7d\x2f\x2f\x20\x49\x66\x20\x69\x74\x73\x20\x61\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x74\x68\x65\x6e\x20\x6c\x65\x74\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x68\x61\x6e\x64\x6c\x65\x20\x74\x68\x69\x73\x2e\x0a\x20\x20\x69\x66\x20\x73\x75\x62\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x5b\x63\x6d\x64\x5d\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x66\x69\x72\x73\x74\x20\x73\x74\x72\x69\x6e\x67\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x72\x65\x73\x74\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x0a\x0a\x20\x20\x20\x20\x69\x66\x20\x6c\x65\x6e\x28\x61\x72\x67\x73\x29\x20\x21\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x72\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x30\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x31\x3a\x5d\x0a\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x73\x75\x62\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x24\x73\x75\x62\x2e\x43\x6c\x65\x61\x6e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x66\x69\x72\x73\x74\x2c\x20\x72\x65\x73\x74\x29\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x65\x6c\x65\x6d\x2e\x4c\x69\x73\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x43\x6f\x6e\x74\x65\x78\x74\x3a\x20\x7b\x7b\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x52\x65\x74\x75\x72\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x75\x72\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x53\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x42\x69\x6e\x61\x72\x79\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x2e\x4d\x61\x69\x6e\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x53\x6f\x75\x72\x63\x65\x3a\x20\x60\x7b\x7b\x2e\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x46\x6c\x61\x67\x73\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x57\x61\x74\x63\x68\x65\x73\x3a\x20\x5b\x5d\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x61\x74\x63\x68\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x57\x61\x74\x63\x68\x65\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x50\x61\x74\x68\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x50\x61\x74\x68\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x47\x6c\x6f\x62\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x47\x6c\x6f\x62\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x69\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x69\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x62\x6f\x75\x6e\x63\x65\x3a\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x7b\x7b\x70\x72\x69\x6e\x74\x66\x20\x22\x25\x64\x22\x20\x2e\x44\x65\x62\x6f\x75\x6e\x63\x65\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x43\x72\x6f\x6e\x73\x3a\x20\x5b\x5d\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x43\x72\x6f\x6e\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x43\x72\x6f\x6e\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x78\x70\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x78\x70\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4f\x76\x65\x72\x6c\x61\x70\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x76\x65\x72\x6c\x61\x70\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4a\x69\x74\x74\x65\x72\x3a\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x7b\x7b\x70\x72\x69\x6e\x74\x66\x20\x22\x25\x64\x22\x20\x2e\x4a\x69\x74\x74\x65\x72\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4c\x6f\x63\x61\x74\x69\x6f\x6e\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4c\x6f\x63\x61\x74\x69\x6f\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x69\x6d\x65\x6f\x75\x74\x3a\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x7b\x7b\x70\x72\x69\x6e\x74\x66\x20\x22\x25\x64\x22\x20\x2e\x54\x69\x6d\x65\x6f\x75\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x2e\x52\x65\x74\x72\x79\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x52\x65\x74\x72\x79\x3a\x20\x26\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x52\x65\x74\x72\x79\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x41\x74\x74\x65\x6d\x70\x74\x73\x3a\x20\x7b\x7b\x2e\x52\x65\x74\x72\x79\x2e\x41\x74\x74\x65\x6d\x70\x74\x73\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x42\x61\x63\x6b\x6f\x66\x66\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x74\x72\x79\x2e\x42\x61\x63\x6b\x6f\x66\x66\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x49\x6e\x69\x74\x69\x61\x6c\x3a\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x7b\x7b\x70\x72\x69\x6e\x74\x66\x20\x22\x25\x64\x22\x20\x2e\x52\x65\x74\x72\x79\x2e\x49\x6e\x69\x74\x69\x61\x6c\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4d\x61\x78\x3a\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x7b\x7b\x70\x72\x69\x6e\x74\x66\x20\x22\x25\x64\x22\x20\x2e\x52\x65\x74\x72\x79\x2e\x4d\x61\x78\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4f\x6e\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x74\x72\x79\x2e\x4f\x6e\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x7b\x7d\x2c\x20\x65\x72\x72\x6f\x72\x73\x2e\x4e\x65\x77\x28\x22\x4e\x6f\x74\x20\x66\x6f\x75\x6e\x64\x22\x29\x0a\x7d\x0a\x0a\x2f\x2f\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x62\x69\x6e\x61\x72\x79\x0a\x2f\x2f\x20\x61\x6e\x64\x20\x69\x74\x73\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x2e\x0a\x66\x75\x6e\x63\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x28\x29\x20\x5b\x5d\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x20\x7b\x0a\x20\x20\x76\x61\x72\x20\x6c\x69\x73\x74\x20\x5b\x5d\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x0a\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x6c\x69\x73\x74\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x6c\x69\x73\x74\x2c\x20\x7b\x7b\x24\x73\x75\x62\x2e\x43\x6c\x65\x61\x6e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x28\x29\x2e\x2e\x2e\x29\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x66\x6f\x72\x20\x5f\x2c\x20\x6e\x61\x6d\x65\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x65\x6c\x65\x6d\x2e\x4c\x69\x73\x74\x7d\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x7d\x20\x7b\x0a\x20\x20\x20\x20\x69\x66\x20\x66\x6e\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x6e\x61\x6d\x65\x2c\x20\x6e\x69\x6c\x29\x3b\x20\x65\x72\x72\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6c\x69\x73\x74\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x6c\x69\x73\x74\x2c\x20\x66\x6e\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6c\x69\x73\x74\x0a\x7d\x0a\x0a\x2f\x2f\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x20\x65\x78\x65\x63\x75\x74\x65\x73\x20\x6e\x65\x63\x65\x73\x73\x61\x72\x79\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x61\x73\x20\x6e\x65\x65\x64\x65\x64\x20\x66\x72\x6f\x6d\x20\x69\x74\x73\x20\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x20\x61\x6e\x64\x0a\x2f\x2f\x20\x77\x72\x69\x74\x65\x73\x20\x63\x6f\x72\x72\x65\x73\x70\x6f\x6e\x64\x69\x6e\x67\x20\x6f\x75\x74\x70\x75\x74\x73\x20\x74\x6f\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x60\x6f\x75\x67\x6f\x69\x6e\x67\x60\x20\x77\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x2e\x0a\x66\x75\x6e\x63\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x28\x63\x6d\x64\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x61\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6c\x61\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x63\x6f\x6e\x74\x65\x78\x74\x2e\x42\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x28\x29\x2c\x20\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x2c\x20\x66\x6c\x61\x67\x73\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x7d\x0a\x0a\x2f\x2f\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x65\x78\x65\x63\x75\x74\x65\x73\x20\x6e\x65\x63\x65\x73\x73\x61\x72\x79\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x6c\x69\x6b\x65\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x2c\x20\x77\x68\x65\x72\x65\x20\x74\x68\x65\x0a\x2f\x2f\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x6f\x66\x20\x63\x61\x6c\x6c\x65\x64\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x61\x72\x65\x20\x64\x65\x72\x69\x76\x65\x64\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x70\x61\x72\x65\x6e\x74\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x0a\x66\x75\x6e\x63\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x63\x6d\x64\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x61\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6c\x61\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x20\x20\x7b\x7b\x20\x69\x66\x20\x6e\x6f\x74\x65\x71\x75\x61\x6c\x20\x28\x6c\x65\x6e\x20\x2e\x53\x75\x62\x73\x29\x20\x30\x7d\x7d\x2f\x2f\x20\x49\x66\x20\x69\x74\x73\x20\x61\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x74\x68\x65\x6e\x20\x6c\x65\x74\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x68\x61\x6e\x64\x6c\x65\x20\x74\x68\x69\x73\x2e\x0a\x20\x20\x69\x66\x20\x73\x75\x62\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x5b\x63\x6d\x64\x5d\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x66\x69\x72\x73\x74\x20\x73\x74\x72\x69\x6e\x67\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x72\x65\x73\x74\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x0a\x0a\x20\x20\x20\x20\x69\x66\x20\x6c\x65\x6e\x28\x61\x72\x67\x73\x29\x20\x21\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x72\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x30\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x31\x3a\x5d\x0a\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x73\x75\x62\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x24\x73\x75\x62\x2e\x43\x6c\x65\x61\x6e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x66\x69\x72\x73\x74\x2c\x20\x72\x65\x73\x74\x2c\x20\x66\x6c\x61\x67\x73\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x66\x6e\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x4d\x65\x74\x61\x28\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x2c\x20\x66\x6c\x61\x67\x73\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x2f\x2f\x20\x45\x76\x65\x72\x79\x20\x69\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x20\x68\x61\x73\x20\x61\x6e\x20\x49\x44\x2c\x20\x77\x68\x69\x63\x68\x20\x74\x68\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x27\x73\x20\x6c\x6f\x67\x67\x65\x72\x20\x61\x64\x64\x73\x20\x74\x6f\x20\x72\x65\x63\x6f\x72\x64\x73\x20\x77\x69\x74\x68\x20\x69\x74\x73\x20\x6e\x61\x6d\x65\x2e\x0a\x20\x20\x69\x66\x20\x5f\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x49\x44\x28\x70\x61\x72\x65\x6e\x74\x29\x3b\x20\x21\x6f\x6b\x20\x7b\x0a\x20\x20\x20\x20\x70\x61\x72\x65\x6e\x74\x20\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x69\x74\x68\x49\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x49\x44\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x65\x77\x49\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x49\x44\x28\x29\x29\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x70\x61\x72\x65\x6e\x74\x20\x3d\x20\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x2e\x46\x6f\x72\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x66\x6e\x2e\x4e\x61\x6d\x65\x29\x0a\x0a\x20\x20\x2f\x2f\x20\x54\x68\x65\x20\x40\x73\x65\x74\x75\x70\x20\x68\x6f\x6f\x6b\x73\x20\x72\x75\x6e\x20\x6f\x6e\x63\x65\x20\x70\x65\x72\x20\x70\x72\x6f\x63\x65\x73\x73\x2c\x20\x62\x65\x66\x6f\x72\x65\x20\x74\x68\x65\x20\x66\x69\x72\x73\x74\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x2e\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x68\x6f\x6f\x6b\x73\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x2f\x2f\x20\x56\x61\x6c\x75\x65\x73\x20\x6f\x66\x20\x40\x70\x72\x6f\x76\x69\x64\x65\x72\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x61\x72\x65\x20\x6d\x61\x64\x65\x20\x6f\x6e\x63\x65\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x69\x6e\x76\x6f\x63\x61\x74\x69\x6f\x6e\x2c\x20\x61\x6e\x64\x20\x63\x6c\x6f\x73\x65\x64\x20\x61\x66\x74\x65\x72\x20\x69\x74\x2e\x0a\x20\x20\x73\x63\x6f\x70\x65\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x4e\x65\x77\x53\x63\x6f\x70\x65\x28\x70\x72\x6f\x76\x69\x64\x65\x72\x73\x29\x0a\x20\x20\x64\x65\x66\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x63\x6f\x70\x65\x2e\x43\x6c\x6f\x73\x65\x28\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x67\x75\x6e\x6c\x6f\x67\x2e\x46\x72\x6f\x6d\x28\x70\x61\x72\x65\x6e\x74\x29\x2e\x57\x61\x72\x6e\x28\x22\x43\x6c\x6f\x73\x69\x6e\x67\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x76\x61\x6c\x75\x65\x73\x20\x66\x61\x69\x6c\x65\x64\x22\x2c\x20\x22\x65\x72\x72\x6f\x72\x22\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x28\x29\x0a\x0a\x20\x20\x70\x61\x72\x65\x6e\x74\x20\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x57\x69\x74\x68\x53\x63\x6f\x70\x65\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x73\x63\x6f\x70\x65\x29\x0a\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x68\x6f\x6f\x6b\x73\x2e\x41\x72\x6f\x75\x6e\x64\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x66\x6e\x2e\x4e\x53\x2c\x20\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x20\x20\x20\x20\x2f\x2f\x20\x41\x70\x70\x6c\x79\x20\x74\x68\x65\x20\x40\x74\x69\x6d\x65\x6f\x75\x74\x20\x61\x6e\x64\x20\x40\x72\x65\x74\x72\x79\x20\x61\x6e\x6e\x6f\x74\x61\x74\x69\x6f\x6e\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x74\x6f\x20\x69\x74\x73\x20\x63\x61\x6c\x6c\x73\x2e\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x6f\x6b\x65\x28\x63\x74\x78\x2c\x20\x66\x6e\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x66\x75\x6e\x63\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x69\x6e\x70\x75\x74\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x74\x69\x6d\x65\x6f\x75\x74\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x63\x74\x78\x2c\x20\x63\x6d\x64\x2c\x20\x61\x72\x67\x73\x2c\x20\x66\x6c\x61\x67\x73\x2c\x20\x69\x6e\x70\x75\x74\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2c\x20\x74\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x29\x0a\x7d\x0a\x0a\x2f\x2f\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x54\x65\x61\x72\x64\x6f\x77\x6e\x20\x72\x75\x6e\x73\x20\x74\x68\x65\x20\x40\x74\x65\x61\x72\x64\x6f\x77\x6e\x20\x68\x6f\x6f\x6b\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x61\x6e\x64\x20\x69\x74\x73\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x0a\x2f\x2f\x20\x77\x68\x6f\x73\x65\x20\x40\x73\x65\x74\x75\x70\x20\x68\x6f\x6f\x6b\x73\x20\x72\x61\x6e\x2c\x20\x77\x68\x69\x63\x68\x20\x73\x68\x6f\x75\x6c\x64\x20\x62\x65\x20\x64\x6f\x6e\x65\x20\x62\x65\x66\x6f\x72\x65\x20\x74\x68\x65\x20\x70\x72\x6f\x63\x65\x73\x73\x20\x65\x6e\x64\x73\x2e\x0a\x66\x75\x6e\x63\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x54\x65\x61\x72\x64\x6f\x77\x6e\x28\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x20\x20\x76\x61\x72\x20\x65\x72\x72\x73\x20\x5b\x5d\x65\x72\x72\x6f\x72\x0a\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x7b\x7b\x24\x73\x75\x62\x2e\x43\x6c\x65\x61\x6e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x54\x65\x61\x72\x64\x6f\x77\x6e\x28\x63\x74\x78\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x65\x72\x72\x73\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x65\x72\x72\x73\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x68\x6f\x6f\x6b\x73\x2e\x53\x74\x6f\x70\x28\x63\x74\x78\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x65\x72\x72\x73\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x65\x72\x72\x73\x2c\x20\x65\x72\x72\x29\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x6f\x72\x73\x2e\x4a\x6f\x69\x6e\x28\x65\x72\x72\x73\x2e\x2e\x2e\x29\x0a\x7d\x0a\x0a\x2f\x2f\x20\x6d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x65\x78\x65\x63\x75\x74\x65\x73\x20\x61\x20\x73\x69\x6e\x67\x6c\x65\x20\x63\x61\x6c\x6c\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x6f\x72\x20\x67\x69\x76\x69\x6e\x67\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x2c\x0a\x2f\x2f\x20\x72\x65\x63\x6f\x76\x65\x72\x69\x6e\x67\x20\x70\x61\x6e\x69\x63\x73\x20\x61\x73\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x50\x61\x6e\x69\x63\x45\x72\x72\x6f\x72\x20\x75\x6e\x6c\x65\x73\x73\x20\x74\x68\x65\x20\x63\x6f\x6e\x74\x65\x78\x74\x20\x73\x61\x79\x73\x20\x6f\x74\x68\x65\x72\x77\x69\x73\x65\x2e\x0a\x66\x75\x6e\x63\x20\x6d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x45\x78\x65\x63\x75\x74\x65\x43\x6f\x6e\x74\x65\x78\x74\x28\x70\x61\x72\x65\x6e\x74\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x2c\x20\x63\x6d\x64\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x61\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6c\x61\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x74\x69\x6d\x65\x2e\x44\x75\x72\x61\x74\x69\x6f\x6e\x29\x20\x28\x65\x72\x72\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0a\x20\x20\x69\x66\x20\x21\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x43\x72\x61\x73\x68\x65\x73\x4f\x6e\x50\x61\x6e\x69\x63\x28\x70\x61\x72\x65\x6e\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x52\x65\x63\x6f\x76\x65\x72\x28\x26\x65\x72\x72\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x65\x6c\x65\x6d\x2e\x4c\x69\x73\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x6e\x6f\x74\x20\x28\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6d\x64\x46\x6c\x61\x67\x73\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x49\x66\x20\x66\x6c\x61\x67\x73\x20\x66\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x6c\x6f\x61\x64\x20\x74\x68\x65\x6e\x20\x63\x72\x79\x6f\x75\x74\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x66\x6c\x61\x67\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x66\x6c\x61\x67\x73\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x63\x6d\x64\x46\x6c\x61\x67\x73\x2e\x4c\x6f\x61\x64\x28\x66\x6c\x61\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6c\x61\x67\x53\x70\x61\x6e\x2e\x46\x61\x69\x6c\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6c\x61\x67\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x69\x6e\x64\x65\x78\x2c\x20\x24\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x3a\x3d\x20\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x7b\x7b\x24\x69\x6e\x64\x65\x78\x7d\x7d\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x50\x72\x6f\x76\x69\x64\x65\x5b\x7b\x7b\x24\x70\x72\x6f\x76\x69\x64\x65\x64\x2e\x54\x79\x70\x65\x7d\x7d\x5d\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x70\x72\x6f\x76\x69\x64\x65\x64\x2e\x54\x79\x70\x65\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4e\x6f\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x4c\x69\x73\x74\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x4c\x69\x73\x74\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x43\x6f\x6e\x74\x65\x78\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x52\x65\x61\x64\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x69\x6f\x2e\x45\x4f\x46\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x53\x6c\x69\x63\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x57\x72\x69\x74\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x52\x65\x61\x64\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x69\x6f\x2e\x45\x4f\x46\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x53\x6c\x69\x63\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x65\x71\x75\x61\x6c\x20\x28\x6c\x65\x6e\x20\x2e\x4d\x61\x69\x6e\x2e\x44\x65\x66\x61\x75\x6c\x74\x29\x20\x30\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x45\x72\x72\x4e\x6f\x44\x65\x66\x61\x75\x6c\x74\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x4d\x61\x69\x6e\x2e\x44\x65\x66\x61\x75\x6c\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x6e\x6f\x74\x20\x28\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x29\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6d\x64\x46\x6c\x61\x67\x73\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x73\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x6c\x61\x67\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x45\x6e\x76\x56\x61\x72\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x44\x65\x73\x63\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x44\x65\x73\x63\x7d\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x54\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x46\x6c\x61\x67\x54\x79\x70\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x2e\x49\x6e\x74\x7d\x7d\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x49\x66\x20\x66\x6c\x61\x67\x73\x20\x66\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x6c\x6f\x61\x64\x20\x74\x68\x65\x6e\x20\x63\x72\x79\x6f\x75\x74\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x66\x6c\x61\x67\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x66\x6c\x61\x67\x73\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x63\x6d\x64\x46\x6c\x61\x67\x73\x2e\x4c\x6f\x61\x64\x28\x66\x6c\x61\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6c\x61\x67\x53\x70\x61\x6e\x2e\x46\x61\x69\x6c\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6c\x61\x67\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x69\x6e\x64\x65\x78\x2c\x20\x24\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x3a\x3d\x20\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x7b\x7b\x24\x69\x6e\x64\x65\x78\x7d\x7d\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x50\x72\x6f\x76\x69\x64\x65\x5b\x7b\x7b\x24\x70\x72\x6f\x76\x69\x64\x65\x64\x2e\x54\x79\x70\x65\x7d\x7d\x5d\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x70\x72\x6f\x76\x69\x64\x65\x64\x2e\x54\x79\x70\x65\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4e\x6f\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x4c\x69\x73\x74\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x4c\x69\x73\x74\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x43\x6f\x6e\x74\x65\x78\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x52\x65\x61\x64\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x69\x6f\x2e\x45\x4f\x46\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x53\x6c\x69\x63\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x57\x72\x69\x74\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x52\x65\x61\x64\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x69\x6f\x2e\x45\x4f\x46\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x64\x61\x74\x61\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x69\x6e\x67\x53\x6c\x69\x63\x65\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x61\x72\x67\x73\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x52\x65\x61\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x53\x74\x72\x75\x63\x74\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x4d\x61\x70\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x49\x6d\x70\x6f\x72\x74\x65\x64\x41\x72\x67\x75\x6d\x65\x6e\x74\x57\x69\x74\x68\x57\x72\x69\x74\x65\x72\x20\x2e\x54\x79\x70\x65\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x20\x74\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x7b\x7b\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x44\x65\x63\x6f\x64\x65\x49\x6e\x70\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x26\x64\x61\x74\x61\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x65\x72\x6e\x61\x6c\x73\x2e\x49\x6e\x76\x61\x6c\x69\x64\x49\x6e\x70\x75\x74\x28\x65\x72\x72\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x4e\x6f\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x50\x72\x65\x66\x69\x78\x7d\x7d\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x47\x6f\x6f\x67\x6c\x65\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x66\x75\x6e\x63\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x70\x61\x72\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x54\x69\x6d\x65\x6f\x75\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x66\x6c\x61\x67\x56\x61\x6c\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x57\x69\x74\x68\x56\x61\x6c\x75\x65\x28\x63\x74\x78\x2c\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6e\x63\x65\x6c\x6c\x65\x72\x28\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x63\x74\x78\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x75\x73\x65\x73\x46\x61\x75\x78\x43\x6f\x6e\x74\x65\x78\x74\x20\x2e\x43\x6f\x6e\x74\x65\x78\x74\x7d\x7d\x62\x61\x67\x20\x3a\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x56\x61\x6c\x75\x65\x42\x61\x67\x46\x72\x6f\x6d\x28\x66\x6c\x61\x67\x56\x61\x6c\x73\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x74\x78\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x43\x6f\x6e\x74\x65\x78\x74\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x20\x3d\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x65\x6c\x73\x65\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x74\x78\x20\x3d\x20\x63\x6f\x6e\x74\x65\x78\x74\x2e\x4e\x65\x77\x45\x78\x70\x69\x72\x69\x6e\x67\x43\x6e\x63\x6c\x43\x6f\x6e\x74\x65\x78\x74\x28\x6e\x69\x6c\x2c\x20\x63\x74\x78\x54\x69\x6d\x65\x6f\x75\x74\x2c\x20\x62\x61\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x74\x78\x2e\x43\x61\x6e\x63\x65\x6c\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x69\x66\x20\x72\x65\x74\x75\x72\x6e\x73\x45\x72\x72\x6f\x72\x20\x2e\x52\x65\x74\x75\x72\x6e\x20\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x5f\x2c\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x20\x3a\x3d\x20\x74\x72\x61\x63\x65\x2e\x53\x74\x61\x72\x74\x28\x70\x61\x72\x65\x6e\x74\x2c\x20\x22\x63\x61\x6c\x6c\x22\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x61\x6c\x6c\x53\x70\x61\x6e\x2e\x45\x6e\x64\x28\x29\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x28\x63\x74\x78\x7b\x7b\x2e\x50\x72\x6f\x76\x69\x64\x65\x64\x53\x75\x66\x66\x69\x78\x7d\x7d\x2c\x20\x7b\x7b\x69\x66\x20\x68\x61\x73\x50\x72\x65\x66\x69\x78\x20\x2e\x49\x6d\x70\x6f\x72\x74\x73\x2e\x54\x79\x70\x65\x20\x22\x2a\x22\x7d\x7d\x26\x64\x61\x74\x61\x7b\x7b\x65\x6c\x73\x65\x7d\x7d\x64\x61\x74\x61\x7b\x7b\x65\x6e\x64\x7d\x7d\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x7d\x0a\x7d\x0a\x0a\x2f\x2f\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x48\x65\x6c\x70\x20\x64\x69\x73\x70\x6c\x61\x79\x20\x68\x65\x6c\x70\x20\x6d\x65\x73\x73\x61\x67\x65\x20\x66\x6f\x72\x20\x65\x78\x65\x63\x75\x74\x61\x62\x6c\x65\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x20\x61\x6e\x64\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x73\x2e\x0a\x66\x75\x6e\x63\x20\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x48\x65\x6c\x70\x28\x73\x6f\x75\x72\x63\x65\x20\x62\x6f\x6f\x6c\x2c\x20\x20\x63\x6d\x64\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x61\x72\x67\x73\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x43\x6c\x6f\x73\x65\x72\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0a\x20\x20\x64\x65\x66\x65\x72\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2e\x43\x6c\x6f\x73\x65\x28\x29\x0a\x0a\x20\x20\x7b\x7b\x20\x69\x66\x20\x6e\x6f\x74\x65\x71\x75\x61\x6c\x20\x28\x6c\x65\x6e\x20\x2e\x53\x75\x62\x73\x29\x20\x30\x7d\x7d\x2f\x2f\x20\x49\x66\x20\x69\x74\x73\x20\x61\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x74\x68\x65\x6e\x20\x6c\x65\x74\x20\x73\x75\x62\x63\x6f\x6d\x6d\x61\x6e\x64\x20\x68\x61\x6e\x64\x6c\x65\x20\x74\x68\x69\x73\x2e\x0a\x20\x20\x69\x66\x20\x73\x75\x62\x43\x6f\x6d\x6d\x61\x6e\x64\x73\x5b\x63\x6d\x64\x5d\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x66\x69\x72\x73\x74\x20\x73\x74\x72\x69\x6e\x67\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x72\x65\x73\x74\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x0a\x0a\x20\x20\x20\x20\x69\x66\x20\x6c\x65\x6e\x28\x61\x72\x67\x73\x29\x20\x21\x3d\x20\x30\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x69\x72\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x30\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x74\x20\x3d\x20\x61\x72\x67\x73\x5b\x31\x3a\x5d\x0a\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x73\x75\x62\x20\x3a\x3d\x20\x2e\x53\x75\x62\x73\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x24\x73\x75\x62\x2e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7b\x24\x73\x75\x62\x2e\x43\x6c\x65\x61\x6e\x42\x69\x6e\x61\x72\x79\x4e\x61\x6d\x65\x7d\x7d\x2e\x4d\x61\x69\x6e\x53\x68\x6f\x67\x75\x6e\x48\x65\x6c\x70\x28\x73\x6f\x75\x72\x63\x65\x2c\x20\x66\x69\x72\x73\x74\x2c\x20\x72\x65\x73\x74\x2c\x20\x69\x6e\x63\x6f\x6d\x69\x6e\x67\x2c\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x29\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x73\x77\x69\x74\x63\x68\x20\x63\x6d\x64\x20\x7b\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x5f\x2c\x20\x24\x65\x6c\x65\x6d\x20\x3a\x3d\x20\x2e\x4d\x61\x69\x6e\x2e\x46\x75\x6e\x63\x74\x69\x6f\x6e\x73\x20\x7d\x7d\x7b\x7b\x72\x61\x6e\x67\x65\x20\x24\x65\x6c\x65\x6d\x2e\x4c\x69\x73\x74\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x63\x61\x73\x65\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x52\x65\x61\x6c\x4e\x61\x6d\x65\x7d\x7d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x73\x6f\x75\x72\x63\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2e\x57\x72\x69\x74\x65\x28\x5b\x5d\x62\x79\x74\x65\x28\x60\x7b\x7b\x2e\x48\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x57\x69\x74\x68\x53\x6f\x75\x72\x63\x65\x7d\x7d\x60\x29\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2e\x57\x72\x69\x74\x65\x28\x5b\x5d\x62\x79\x74\x65\x28\x60\x7b\x7b\x2e\x48\x65\x6c\x70\x4d\x65\x73\x73\x61\x67\x65\x7d\x7d\x60\x29\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0a\x20\x20\x20\x20\x64\x65\x66\x61\x75\x6c\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x75\x74\x67\x6f\x69\x6e\x67\x2e\x57\x72\x69\x74\x65\x28\x5b\x5d\x62\x79\x74\x65\x28\x60\x7b\x7b\x2e\x48\x65\x6c\x70\x7d\x7d\x60\x29\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0a\x7d\x0a")
 
 }
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/influx6/shogun/internals/trace"
+	"github.com/minio/cli"
+)
+
+// startTrace returns a context which records spans if the `--trace` flag is set, and
+// a function which writes them into its file. The file is shared with executed
+// binaries, which append their own spans to it.
+func startTrace(c *cli.Context, parent context.Context) (context.Context, func(), error) {
+	path, format := c.GlobalString("trace"), c.GlobalString("trace-format")
+	if path == "" {
+		return parent, func() {}, nil
+	}
+
+	owner, err := trace.Share(path, format)
+	if err != nil {
+		return parent, nil, err
+	}
+
+	tracer := trace.New(os.Getpid())
+
+	return trace.With(parent, tracer), func() {
+		err := trace.Write(path, format, tracer.Spans())
+		if err == nil && owner {
+			err = trace.Finish(path, format)
+		}
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⡿ Failed to write trace: %s\n", err)
+		}
+	}, nil
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/influx6/faux/exec"
+	"github.com/influx6/faux/metrics"
+	"github.com/influx6/faux/metrics/custom"
+	"github.com/influx6/gobuild/build"
+	"github.com/influx6/shogun/internals/samurai"
+	"github.com/influx6/shogun/internals/watch"
+	"github.com/minio/cli"
+)
+
+func watchAction(c *cli.Context) error {
+	events := metrics.New()
+
+	if c.Bool("verbose") {
+		events = metrics.New(custom.StackDisplay(os.Stdout))
+	}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		events.Emit(metrics.Errorf("Failed to read current directory: %q", err))
+		return err
+	}
+
+	targetDir := filepath.Join(currentDir, c.String("dir"))
+
+	binaryPath := binPath()
+	if binDir := c.String("binDir"); binDir != "" {
+		binaryPath = binDir
+	}
+
+	cmdDir := c.String("cmdDir")
+	if cmdDir == "" {
+		cmdDir = "cmd"
+	}
+
+	cmdDir = filepath.Join(currentDir, cmdDir)
+
+	debounce := 300 * time.Millisecond
+	if value := c.String("debounce"); value != "" {
+		debounce, err = time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("Invalid debounce duration: %+q", err)
+		}
+	}
+
+	// Cache stdin once, so every rerun of the chosen function receives the same input.
+	var cachedInput []byte
+	if c.NArg() != 0 && stdinHasData() {
+		if cachedInput, err = io.ReadAll(os.Stdin); err != nil {
+			return err
+		}
+	}
+
+	ctx := build.Default
+	ctx.BuildTags = append(ctx.BuildTags, "shogun")
+	ctx.RequiredTags = append(ctx.RequiredTags, "shogun")
+
+	hashes, err := samurai.ListPackageHash(events, events, targetDir, ctx)
+	if err != nil && err != samurai.ErrSkipDir {
+		return err
+	}
+
+	watcher, err := watch.New(debounce)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Close()
+
+	watcher.Dirs = func(path string, info os.FileInfo) bool {
+		return !strings.HasPrefix(info.Name(), ".") && !strings.HasPrefix(path, cmdDir)
+	}
+
+	watcher.Files = func(path string, _ os.FileInfo) bool {
+		return filepath.Ext(path) == ".go" && !strings.HasPrefix(path, cmdDir)
+	}
+
+	// Shogun packages live within the target directory and its first level directories.
+	if err := watcher.Add(targetDir, 1); err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	rebuild := func() {
+		if err := buildQuietly(c); err != nil {
+			fmt.Fprintf(os.Stderr, "⡿ Build failed: %s\n", err)
+			return
+		}
+
+		if c.NArg() == 0 {
+			return
+		}
+
+		command := append([]string{filepath.Join(binaryPath, c.Args().First())}, c.Args().Tail()...)
+		fmt.Fprintf(os.Stderr, "⡿ Executing %+q:\n", strings.Join(command, " "))
+
+		exitCode, err := exec.New(
+			exec.Async(),
+			exec.Commands(command...),
+			exec.Input(bytes.NewReader(cachedInput)),
+			exec.Output(os.Stdout),
+			exec.Err(os.Stderr),
+		).ExecWithExitCode(runCtx, events)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⡿ Command failed (exitCode: %d): %s\n", exitCode, err)
+		}
+	}
+
+	rebuild()
+	fmt.Fprintf(os.Stderr, "⠙ Watching %q for changes...\n", targetDir)
+
+	err = watcher.Run(runCtx, func(paths []string) {
+		latest, err := samurai.ListPackageHash(events, events, targetDir, ctx)
+		if err != nil && err != samurai.ErrSkipDir {
+			fmt.Fprintf(os.Stderr, "⡿ Failed to hash packages: %s\n", err)
+			return
+		}
+
+		changed := changedPackages(hashes, latest)
+		if len(changed) == 0 {
+			return
+		}
+
+		hashes = latest
+		for _, pkg := range changed {
+			fmt.Fprintf(os.Stderr, "⠙ Changed: %q\n", pkg)
+		}
+
+		rebuild()
+	}, func(err error) {
+		events.Emit(metrics.Errorf("Watcher error: %+q", err))
+	})
+
+	if err == context.Canceled {
+		return nil
+	}
+
+	return err
+}
+
+// changedPackages returns the paths of packages whose hashes differ between prev and next.
+func changedPackages(prev, next samurai.PackageHashList) []string {
+	var changed []string
+
+	if prev.Main.Hash != next.Main.Hash {
+		changed = append(changed, next.Dir)
+	}
+
+	for path, sub := range next.Subs {
+		if old, ok := prev.Subs[path]; !ok || old.Hash != sub.Hash {
+			changed = append(changed, path)
+		}
+	}
+
+	for path := range prev.Subs {
+		if _, ok := next.Subs[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+
+	return changed
+}